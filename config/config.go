@@ -1,25 +1,138 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
+const defaultRefreshInterval = 1 * time.Second
+
+// Config is the effective configuration, resolved with the following precedence
+// (highest first): command-line flags, environment variables, the selected
+// profile from the config file, built-in defaults.
 type Config struct {
+	Profile  string
 	Database struct {
 		URL string
 	}
 	RefreshInterval time.Duration
+	Filters         Filters
+	Theme           map[string]string
+	ReadOnly        bool
+
+	// Set when read-only mode is forced by a flag or the environment, so it survives profile switches
+	forceReadOnly bool
+	// Set when the refresh interval is given by a flag or the environment, so it survives profile switches
+	forceRefresh time.Duration
+
+	// Path of the config file that was loaded, empty if none
+	File string
+	// Profiles holds every profile defined in the config file
+	Profiles map[string]Profile
+}
+
+// Filters are the job list filters applied when the monitor starts
+type Filters struct {
+	States []string `yaml:"states,omitempty"`
+	Kinds  []string `yaml:"kinds,omitempty"`
+	Queues []string `yaml:"queues,omitempty"`
+}
+
+// Profile is a named connection in the config file
+type Profile struct {
+	DatabaseURL string            `yaml:"database_url"`
+	Refresh     time.Duration     `yaml:"refresh,omitempty"`
+	Filters     Filters           `yaml:"filters,omitempty"`
+	Theme       map[string]string `yaml:"theme,omitempty"`
+	ReadOnly    bool              `yaml:"read_only,omitempty"`
+}
+
+// File is the layout of the config file
+type File struct {
+	DefaultProfile string             `yaml:"default_profile"`
+	Profiles       map[string]Profile `yaml:"profiles"`
+}
+
+// DefaultPath returns the config file location, honoring XDG_CONFIG_HOME
+func DefaultPath() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "rivertui", "config.yaml")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "rivertui", "config.yaml")
 }
 
-// LoadConfig loads the configuration from environment variables
-func LoadConfig() (*Config, error) {
-	config := &Config{}
+// LoadFile reads and parses a config file
+func LoadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{}
+	if err := yaml.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return file, nil
+}
+
+// LoadConfig loads the configuration from the config file and environment variables.
+// An empty path uses RIVER_CONFIG or the default location, where a missing file is not an error.
+// An empty profile uses RIVER_PROFILE or the file's default profile. A profile
+// given by flag takes precedence over RIVER_DATABASE_URL, as flags do over the
+// environment.
+func LoadConfig(path, profile string) (*Config, error) {
+	config := &Config{
+		RefreshInterval: defaultRefreshInterval,
+	}
+
+	if path == "" {
+		path = os.Getenv("RIVER_CONFIG")
+	}
+	profileFlag := profile != ""
+	if !profileFlag {
+		profile = os.Getenv("RIVER_PROFILE")
+	}
+	explicitPath := path != ""
+	if !explicitPath {
+		path = DefaultPath()
+	}
 
-	// Load database URL from environment
-	if dbURL := os.Getenv("RIVER_DATABASE_URL"); dbURL != "" {
-		config.Database.URL = dbURL
+	if path != "" {
+		file, err := LoadFile(path)
+		switch {
+		case err == nil:
+			config.File = path
+			config.Profiles = file.Profiles
+			if profile == "" {
+				profile = file.DefaultProfile
+			}
+		case errors.Is(err, os.ErrNotExist) && !explicitPath:
+			// No config file, rely on environment and flags
+		default:
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+
+	if readOnly := os.Getenv("RIVER_READ_ONLY"); readOnly != "" {
+		forced, err := strconv.ParseBool(readOnly)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RIVER_READ_ONLY value: %w", err)
+		}
+		config.forceReadOnly = forced
+		config.ReadOnly = forced
 	}
 
 	// Load timeout from environment
@@ -28,20 +141,106 @@ func LoadConfig() (*Config, error) {
 		if err != nil {
 			return nil, fmt.Errorf("invalid RIVER_CLI_TIMEOUT value: %w", err)
 		}
+		config.forceRefresh = timeout
 		config.RefreshInterval = timeout
-	} else {
-		config.RefreshInterval = 1 * time.Second
+	}
+
+	if profile != "" {
+		if err := config.ApplyProfile(profile); err != nil {
+			return nil, err
+		}
+	}
+
+	// Load database URL from environment, unless a profile was picked by flag
+	if dbURL := os.Getenv("RIVER_DATABASE_URL"); dbURL != "" && !profileFlag {
+		config.overrideURL(dbURL)
 	}
 
 	return config, nil
 }
 
+// ApplyProfile overlays the named profile from the config file
+func (c *Config) ApplyProfile(name string) error {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		if c.File == "" {
+			return fmt.Errorf("profile %q requested but no config file was found at %s", name, DefaultPath())
+		}
+		return fmt.Errorf("profile %q not found in %s", name, c.File)
+	}
+
+	c.Profile = name
+	c.Database.URL = profile.DatabaseURL
+	switch {
+	case c.forceRefresh > 0:
+		c.RefreshInterval = c.forceRefresh
+	case profile.Refresh > 0:
+		c.RefreshInterval = profile.Refresh
+	default:
+		c.RefreshInterval = defaultRefreshInterval
+	}
+	c.Filters = profile.Filters
+	c.Theme = profile.Theme
+	c.ReadOnly = profile.ReadOnly || c.forceReadOnly
+	return nil
+}
+
+// overrideURL sets a database URL given outside the config file. When it
+// differs from the URL of the selected profile, the profile name no longer
+// describes the connection, so it is cleared rather than shown for a database
+// the profile doesn't point at.
+func (c *Config) overrideURL(dbURL string) {
+	if dbURL == c.Database.URL {
+		return
+	}
+	c.Database.URL = dbURL
+	c.Profile = ""
+}
+
+// ProfileNames returns the names of all profiles in the config file, sorted
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // UpdateConfigFromFlags updates the configuration with values from command-line flags
-func UpdateConfigFromFlags(config *Config, dbURL string, refreshInterval time.Duration) {
+func UpdateConfigFromFlags(config *Config, dbURL string, refreshInterval time.Duration, readOnly bool) {
 	if dbURL != "" {
-		config.Database.URL = dbURL
+		config.overrideURL(dbURL)
 	}
 	if refreshInterval != 0 {
+		config.forceRefresh = refreshInterval
 		config.RefreshInterval = refreshInterval
 	}
+	if readOnly {
+		config.forceReadOnly = true
+		config.ReadOnly = true
+	}
+}
+
+var dsnPasswordRegex = regexp.MustCompile(`(password\s*=\s*)('[^']*'|\S+)`)
+
+// RedactURL hides the password in a connection URL or key/value connection
+// string. URLs can carry a password both in their userinfo and in a password
+// query parameter, and both are redacted.
+func RedactURL(dbURL string) string {
+	u, err := url.Parse(dbURL)
+	if err != nil || u.Scheme == "" {
+		return dsnPasswordRegex.ReplaceAllString(dbURL, "${1}xxxxx")
+	}
+
+	if u.User != nil {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			u.User = url.UserPassword(u.User.Username(), "xxxxx")
+		}
+	}
+	if query := u.Query(); query.Has("password") {
+		query.Set("password", "xxxxx")
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
 }
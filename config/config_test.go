@@ -0,0 +1,77 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadConfigDatabaseURLPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	file := `
+default_profile: local
+profiles:
+  local:
+    database_url: postgres://localhost/app
+  staging:
+    database_url: postgres://staging/app
+`
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		flag        string
+		envProfile  string
+		envURL      string
+		wantProfile string
+		wantURL     string
+	}{
+		{"default profile", "", "", "", "local", "postgres://localhost/app"},
+		{"environment URL overrides the default profile", "", "", "postgres://other/app", "", "postgres://other/app"},
+		{"environment URL overrides the environment profile", "", "staging", "postgres://other/app", "", "postgres://other/app"},
+		{"profile flag overrides the environment URL", "staging", "", "postgres://other/app", "staging", "postgres://staging/app"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RIVER_PROFILE", tt.envProfile)
+			t.Setenv("RIVER_DATABASE_URL", tt.envURL)
+			t.Setenv("RIVER_READ_ONLY", "")
+			t.Setenv("RIVER_CLI_TIMEOUT", "")
+
+			cfg, err := LoadConfig(path, tt.flag)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Profile != tt.wantProfile || cfg.Database.URL != tt.wantURL {
+				t.Errorf("got profile %q with %s, want %q with %s", cfg.Profile, cfg.Database.URL, tt.wantProfile, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestApplyProfileKeepsRefreshFlag(t *testing.T) {
+	cfg := &Config{RefreshInterval: defaultRefreshInterval, Profiles: map[string]Profile{
+		"local":   {Refresh: 5 * time.Second},
+		"staging": {},
+	}}
+	if err := cfg.ApplyProfile("local"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.ApplyProfile("staging"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.RefreshInterval != defaultRefreshInterval {
+		t.Errorf("got refresh %s from a profile without one, want the default", cfg.RefreshInterval)
+	}
+
+	UpdateConfigFromFlags(cfg, "", 2*time.Second, false)
+	if err := cfg.ApplyProfile("local"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.RefreshInterval != 2*time.Second {
+		t.Errorf("got refresh %s after switching profiles, want the flag's 2s", cfg.RefreshInterval)
+	}
+}
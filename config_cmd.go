@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/almottier/rivertui/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	configCmd = &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the rivertui configuration",
+	}
+
+	configViewCmd = &cobra.Command{
+		Use:          "view",
+		Short:        "Print the effective configuration with passwords redacted",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := loadConfig(cmd); err != nil {
+				return err
+			}
+
+			encoder := yaml.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent(2)
+			if err := encoder.Encode(newConfigView(appConfig)); err != nil {
+				return fmt.Errorf("failed to encode configuration: %w", err)
+			}
+			return encoder.Close()
+		},
+	}
+)
+
+// configView is the printable form of the effective configuration
+type configView struct {
+	ConfigFile  string            `yaml:"config_file"`
+	Profile     string            `yaml:"profile"`
+	Profiles    []string          `yaml:"profiles"`
+	DatabaseURL string            `yaml:"database_url"`
+	Refresh     time.Duration     `yaml:"refresh"`
+	ReadOnly    bool              `yaml:"read_only"`
+	Filters     config.Filters    `yaml:"filters"`
+	Theme       map[string]string `yaml:"theme,omitempty"`
+}
+
+func newConfigView(cfg *config.Config) configView {
+	return configView{
+		ConfigFile:  cfg.File,
+		Profile:     cfg.Profile,
+		Profiles:    cfg.ProfileNames(),
+		DatabaseURL: config.RedactURL(cfg.Database.URL),
+		Refresh:     cfg.RefreshInterval,
+		ReadOnly:    cfg.ReadOnly,
+		Filters:     cfg.Filters,
+		Theme:       cfg.Theme,
+	}
+}
+
+func init() {
+	configCmd.AddCommand(configViewCmd)
+	rootCmd.AddCommand(configCmd)
+}
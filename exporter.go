@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/almottier/rivertui/internal/exporter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exporterListen   string
+	exporterInterval time.Duration
+
+	exporterCmd = &cobra.Command{
+		Use:          "exporter",
+		Short:        "Serve River job and queue metrics in the Prometheus text format",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if exporterInterval <= 0 {
+				return fmt.Errorf("invalid interval %s, must be positive", exporterInterval)
+			}
+
+			// The exporter only reads, so always connect in read-only mode
+			readOnly = true
+			if err := connect(cmd); err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			logger := log.New(cmd.ErrOrStderr(), "", log.LstdFlags)
+			exp := exporter.New(appClient, exporterInterval, logger)
+			go exp.Run(ctx)
+
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", exp)
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/" {
+					http.NotFound(w, r)
+					return
+				}
+				fmt.Fprintln(w, `<html><body><a href="/metrics">Metrics</a></body></html>`)
+			})
+
+			server := &http.Server{
+				Addr:              exporterListen,
+				Handler:           mux,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				server.Shutdown(shutdownCtx)
+			}()
+
+			logger.Printf("serving metrics on %s/metrics every %s", exporterListen, exporterInterval)
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("failed to serve metrics: %w", err)
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	exporterCmd.Flags().StringVar(&exporterListen, "listen", ":9187", "Address to serve metrics on")
+	exporterCmd.Flags().DurationVar(&exporterInterval, "interval", 15*time.Second, "How often to query the database")
+	rootCmd.AddCommand(exporterCmd)
+}
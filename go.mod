@@ -12,6 +12,7 @@ require (
 	github.com/riverqueue/river/rivertype v0.11.4
 	github.com/rivo/tview v0.0.0-20250501113434-0c592cd31026
 	github.com/spf13/cobra v1.8.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+)
+
+// The batch operations below change many jobs in a single statement. Jobs
+// that no longer exist or whose state doesn't allow the change are skipped,
+// and each operation returns the number of jobs it changed.
+
+// jobRetryManyQuery makes jobs available to run again the way River's
+// JobRetry does, leaving running jobs alone and jobs already waiting in the
+// queue in their place
+const jobRetryManyQuery = `
+UPDATE river_job
+SET
+	state = 'available',
+	scheduled_at = CASE WHEN state = 'available' AND scheduled_at < now() THEN scheduled_at ELSE now() END,
+	max_attempts = CASE WHEN attempt = max_attempts THEN max_attempts + 1 ELSE max_attempts END,
+	finalized_at = NULL
+WHERE id = any($1::bigint[]) AND state <> 'running'
+RETURNING id`
+
+// jobCancelManyQuery cancels jobs the way River's JobCancel does: jobs
+// waiting to run are cancelled right away, while running jobs are marked
+// and their client is notified so it cancels their context
+const jobCancelManyQuery = `
+WITH notification AS (
+	SELECT
+		id,
+		CASE WHEN state = 'running' THEN pg_notify(
+			concat(current_schema(), '.', 'river_control'),
+			json_build_object('action', 'cancel', 'job_id', id, 'queue', queue)::text
+		) END
+	FROM river_job
+	WHERE id = any($1::bigint[])
+		AND state NOT IN ('cancelled', 'completed', 'discarded')
+		AND finalized_at IS NULL
+	FOR UPDATE
+)
+UPDATE river_job
+SET
+	state = CASE WHEN state = 'running' THEN state ELSE 'cancelled' END,
+	finalized_at = CASE WHEN state = 'running' THEN finalized_at ELSE now() END,
+	metadata = jsonb_set(metadata, '{cancel_attempted_at}'::text[], to_jsonb(now()), true),
+	unique_key = CASE WHEN state = 'running' THEN unique_key ELSE NULL END
+FROM notification
+WHERE river_job.id = notification.id
+RETURNING river_job.id`
+
+const jobDeleteManyQuery = `
+DELETE FROM river_job
+WHERE id = any($1::bigint[]) AND state <> 'running'
+RETURNING id`
+
+// JobRetryMany makes the jobs that aren't running available to run again
+func (c *Client) JobRetryMany(ctx context.Context, ids []int64) (int, error) {
+	return c.countReturned(ctx, jobRetryManyQuery, ids)
+}
+
+// JobCancelMany cancels the jobs that aren't finalized yet, or asks their
+// client to cancel them when they are running
+func (c *Client) JobCancelMany(ctx context.Context, ids []int64) (int, error) {
+	return c.countReturned(ctx, jobCancelManyQuery, ids)
+}
+
+// JobDeleteMany deletes the jobs that aren't running
+func (c *Client) JobDeleteMany(ctx context.Context, ids []int64) (int, error) {
+	return c.countReturned(ctx, jobDeleteManyQuery, ids)
+}
+
+// JobUpdateMany reschedules, reprioritizes or moves the jobs still waiting to run
+func (c *Client) JobUpdateMany(ctx context.Context, ids []int64, params JobUpdateParams) (int, error) {
+	if err := params.Validate(); err != nil {
+		return 0, err
+	}
+	return c.countReturned(ctx, jobUpdateQuery, ids, params.queryArgs()...)
+}
+
+// countReturned runs a batch statement on the jobs and counts the rows it returns
+func (c *Client) countReturned(ctx context.Context, query string, ids []int64, args ...any) (int, error) {
+	rows, err := c.Pool.Query(ctx, query, append([]any{ids}, args...)...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	return count, rows.Err()
+}
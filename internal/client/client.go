@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/almottier/rivertui/config"
@@ -10,8 +11,12 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+	"github.com/riverqueue/river/rivertype"
 )
 
+// ErrJobRunning is returned when trying to delete a job that is currently running
+var ErrJobRunning = errors.New("job is running and cannot be deleted")
+
 // Client holds the database and River clients
 type Client struct {
 	Pool        *pgxpool.Pool
@@ -20,7 +25,7 @@ type Client struct {
 
 // New creates a new client with database and River connections
 func New(ctx context.Context, cfg *config.Config) (*Client, error) {
-	pool, err := db.Connect(ctx, cfg.Database.URL, cfg.RefreshInterval)
+	pool, err := db.Connect(ctx, cfg.Database.URL, cfg.RefreshInterval, cfg.ReadOnly)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -43,3 +48,68 @@ func (c *Client) Close() {
 		c.Pool.Close()
 	}
 }
+
+// JobGet returns a job by ID
+func (c *Client) JobGet(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	return c.RiverClient.JobGet(ctx, id)
+}
+
+// JobRetry makes a job available to run again
+func (c *Client) JobRetry(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	return c.RiverClient.JobRetry(ctx, id)
+}
+
+// JobCancel cancels a job
+func (c *Client) JobCancel(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	return c.RiverClient.JobCancel(ctx, id)
+}
+
+// QueueList returns up to limit queues
+func (c *Client) QueueList(ctx context.Context, limit int) ([]*rivertype.Queue, error) {
+	result, err := c.RiverClient.QueueList(ctx, river.NewQueueListParams().First(limit))
+	if err != nil {
+		return nil, err
+	}
+	return result.Queues, nil
+}
+
+// QueuePause pauses a queue
+func (c *Client) QueuePause(ctx context.Context, name string) error {
+	return c.RiverClient.QueuePause(ctx, name, nil)
+}
+
+// QueueResume resumes a paused queue
+func (c *Client) QueueResume(ctx context.Context, name string) error {
+	return c.RiverClient.QueueResume(ctx, name, nil)
+}
+
+// JobDelete deletes a job that is not running and returns it as it was before deletion
+func (c *Client) JobDelete(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	tx, err := c.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	job, err := c.RiverClient.JobGetTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.State == rivertype.JobStateRunning {
+		return nil, ErrJobRunning
+	}
+
+	// Guard against the job having started between the read and the delete
+	tag, err := tx.Exec(ctx, "DELETE FROM river_job WHERE id = $1 AND state <> 'running'", id)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrJobRunning
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// RawJobArgs are the JSON args of a job of any kind, so jobs can be
+// inserted without the Go type of their args
+type RawJobArgs struct {
+	JobKind string
+	Args    json.RawMessage
+}
+
+// Kind returns the kind of the job
+func (a RawJobArgs) Kind() string {
+	return a.JobKind
+}
+
+// MarshalJSON returns the args unchanged
+func (a RawJobArgs) MarshalJSON() ([]byte, error) {
+	return a.Args, nil
+}
+
+// JobInsertParams describe a job to insert. Zero values get River's defaults,
+// and a zero ScheduledAt makes the job available right away.
+type JobInsertParams struct {
+	Kind        string
+	Args        json.RawMessage
+	Queue       string
+	Priority    int
+	MaxAttempts int
+	Tags        []string
+	Metadata    json.RawMessage
+	ScheduledAt time.Time
+	Unique      UniqueBy
+}
+
+// UniqueBy skips an insert when a job of the same kind already exists in
+// one of the States, with the same args, in the same queue and within the
+// same period, as far as each is set. The zero value inserts unconditionally.
+type UniqueBy struct {
+	Args   bool
+	Queue  bool
+	Period time.Duration
+	// States defaults to DefaultUniqueStates
+	States []rivertype.JobState
+}
+
+// DefaultUniqueStates are the states of the jobs a unique insert checks when none are given
+var DefaultUniqueStates = []rivertype.JobState{
+	rivertype.JobStateAvailable,
+	rivertype.JobStateCompleted,
+	rivertype.JobStateRunning,
+	rivertype.JobStateRetryable,
+	rivertype.JobStateScheduled,
+}
+
+// IsZero reports whether inserts are unconditional
+func (u UniqueBy) IsZero() bool {
+	return !u.Args && !u.Queue && u.Period == 0 && len(u.States) == 0
+}
+
+// ParseUniqueBy parses uniqueness options, one per item: args, queue,
+// period=<duration> or state=<state>
+func ParseUniqueBy(items []string) (UniqueBy, error) {
+	var unique UniqueBy
+	for _, item := range items {
+		name, value, hasValue := strings.Cut(strings.TrimSpace(item), "=")
+		switch {
+		case name == "":
+			continue
+		case name == "args" && !hasValue:
+			unique.Args = true
+		case name == "queue" && !hasValue:
+			unique.Queue = true
+		case name == "period" && hasValue:
+			period, err := time.ParseDuration(value)
+			if err != nil || period < time.Second {
+				return UniqueBy{}, fmt.Errorf("invalid unique period %q: expected a duration of at least 1s", value)
+			}
+			unique.Period = period
+		case name == "state" && hasValue:
+			state := rivertype.JobState(value)
+			if !slices.Contains(rivertype.JobStates(), state) {
+				return UniqueBy{}, fmt.Errorf("unknown job state %q", value)
+			}
+			unique.States = append(unique.States, state)
+		default:
+			return UniqueBy{}, fmt.Errorf("invalid unique option %q: expected args, queue, period=<duration> or state=<state>", item)
+		}
+	}
+	return unique, nil
+}
+
+// ParseScheduledAt parses when to run a job: now, an RFC 3339 time, a date,
+// or a duration from now such as 30m or 2h. An empty value means right away.
+func ParseScheduledAt(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	switch value {
+	case "":
+		return time.Time{}, nil
+	case "now":
+		return now, nil
+	}
+	if delay, err := time.ParseDuration(strings.TrimPrefix(value, "+")); err == nil {
+		return now.Add(delay), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateTime, value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid schedule time %q: expected now, a duration from now (30m, 2h), a date or an RFC 3339 time", value)
+}
+
+// Validate checks the params before inserting them
+func (p JobInsertParams) Validate() error {
+	if p.Kind == "" {
+		return errors.New("job kind is required")
+	}
+	if !isJSONObject(p.Args) {
+		return errors.New("job args must be a JSON object")
+	}
+	if len(p.Metadata) > 0 && !isJSONObject(p.Metadata) {
+		return errors.New("job metadata must be a JSON object")
+	}
+	if p.Priority < 0 || p.Priority > 4 {
+		return fmt.Errorf("priority must be between 1 and 4, got %d", p.Priority)
+	}
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("max attempts must be positive, got %d", p.MaxAttempts)
+	}
+	return nil
+}
+
+func isJSONObject(raw json.RawMessage) bool {
+	var object map[string]any
+	return json.Unmarshal(raw, &object) == nil && object != nil
+}
+
+// JobInsert inserts a job through River, which needs no worker for its kind.
+// For a unique insert skipped as a duplicate, the result holds the existing job.
+func (c *Client) JobInsert(ctx context.Context, params JobInsertParams) (*rivertype.JobInsertResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	return c.RiverClient.Insert(ctx, RawJobArgs{JobKind: params.Kind, Args: params.Args}, &river.InsertOpts{
+		Queue:       params.Queue,
+		Priority:    params.Priority,
+		MaxAttempts: params.MaxAttempts,
+		Tags:        params.Tags,
+		Metadata:    params.Metadata,
+		ScheduledAt: params.ScheduledAt,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   params.Unique.Args,
+			ByQueue:  params.Unique.Queue,
+			ByPeriod: params.Unique.Period,
+			ByState:  params.Unique.States,
+		},
+	})
+}
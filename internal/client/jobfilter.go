@@ -0,0 +1,236 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Comparison operators of a JobComparison
+const (
+	OpEqual          = "="
+	OpNotEqual       = "!="
+	OpLess           = "<"
+	OpLessOrEqual    = "<="
+	OpGreater        = ">"
+	OpGreaterOrEqual = ">="
+)
+
+var sqlOperators = map[string]string{
+	OpEqual:          "=",
+	OpNotEqual:       "<>",
+	OpLess:           "<",
+	OpLessOrEqual:    "<=",
+	OpGreater:        ">",
+	OpGreaterOrEqual: ">=",
+}
+
+// ComparableFields maps the columns a JobComparison can use to their SQL type
+var ComparableFields = map[string]string{
+	"id":           "bigint",
+	"attempt":      "smallint",
+	"max_attempts": "smallint",
+	"priority":     "smallint",
+	"created_at":   "timestamptz",
+	"scheduled_at": "timestamptz",
+	"attempted_at": "timestamptz",
+	"finalized_at": "timestamptz",
+}
+
+// JobComparison restricts jobs to those whose field compares to the value.
+// Numeric fields take an int64. Time fields take a time.Time, or a
+// time.Duration meaning that long before the query runs.
+type JobComparison struct {
+	Field string
+	Op    string
+	Value any
+}
+
+// JSONMatch restricts jobs to those whose args or metadata hold one of the
+// values at the path
+type JSONMatch struct {
+	Column string // "args" or "metadata"
+	Path   []string
+	Values []any
+}
+
+// Document returns the JSON object holding the value at the path, as used
+// for a containment test
+func (m JSONMatch) Document(value any) ([]byte, error) {
+	for i := len(m.Path) - 1; i >= 0; i-- {
+		value = map[string]any{m.Path[i]: value}
+	}
+	return json.Marshal(value)
+}
+
+// TextMatch restricts jobs to those containing the text, ignoring case, in
+// any of the columns: "args", "metadata" or "errors" for the error messages.
+// With a path, only the args or metadata value at the path is searched.
+// The text may contain * wildcards.
+type TextMatch struct {
+	Columns []string
+	Path    []string
+	Text    string
+
+	// pattern is the text compiled by NewTextMatch, for matching outside SQL
+	pattern *regexp.Regexp
+}
+
+// NewTextMatch returns a text match with its text compiled once for Contains
+func NewTextMatch(columns, path []string, text string) TextMatch {
+	return TextMatch{Columns: columns, Path: path, Text: text, pattern: compileText(text)}
+}
+
+// Contains reports whether the value contains the text, ignoring case
+func (m TextMatch) Contains(value string) bool {
+	pattern := m.pattern
+	if pattern == nil {
+		pattern = compileText(m.Text)
+	}
+	return pattern.MatchString(value)
+}
+
+func compileText(text string) *regexp.Regexp {
+	return regexp.MustCompile("(?i)" + strings.ReplaceAll(regexp.QuoteMeta(text), `\*`, ".*"))
+}
+
+// IsPattern reports whether a kind or queue contains a * wildcard
+func IsPattern(value string) bool {
+	return strings.Contains(value, "*")
+}
+
+// Pattern is a kind or queue pattern, where * matches any run of
+// characters, compiled once to be matched against many values
+type Pattern struct {
+	text string
+	re   *regexp.Regexp
+}
+
+// CompilePatterns compiles kind or queue patterns
+func CompilePatterns(texts []string) []Pattern {
+	patterns := make([]Pattern, len(texts))
+	for i, text := range texts {
+		patterns[i] = Pattern{text: text}
+		if IsPattern(text) {
+			patterns[i].re = regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(text), `\*`, ".*") + "$")
+		}
+	}
+	return patterns
+}
+
+// Match reports whether the value matches the pattern
+func (p Pattern) Match(value string) bool {
+	if p.re == nil {
+		return p.text == value
+	}
+	return p.re.MatchString(value)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`, "*", "%")
+
+// whereBuilder collects the conditions of a query and their named arguments
+type whereBuilder struct {
+	conditions []string
+	args       pgx.NamedArgs
+}
+
+// arg adds a named argument and returns its placeholder
+func (b *whereBuilder) arg(value any) string {
+	name := fmt.Sprintf("arg%d", len(b.args))
+	b.args[name] = value
+	return "@" + name
+}
+
+func (b *whereBuilder) add(format string, a ...any) {
+	b.conditions = append(b.conditions, fmt.Sprintf(format, a...))
+}
+
+// matchAny restricts the text column to the values, which may be patterns
+func (b *whereBuilder) matchAny(column string, values []string) {
+	b.add("%s", b.anyOf(column, values))
+}
+
+// matchNone excludes the values, which may be patterns, from the text column
+func (b *whereBuilder) matchNone(column string, values []string) {
+	b.add("NOT (%s)", b.anyOf(column, values))
+}
+
+// anyOf returns a condition on the text column matching any of the values
+func (b *whereBuilder) anyOf(column string, values []string) string {
+	patterns := make([]string, len(values))
+	hasPattern := false
+	for i, value := range values {
+		patterns[i] = likeEscaper.Replace(value)
+		hasPattern = hasPattern || IsPattern(value)
+	}
+	if hasPattern {
+		return fmt.Sprintf("%s LIKE any(%s::text[])", column, b.arg(patterns))
+	}
+	return fmt.Sprintf("%s = any(%s::text[])", column, b.arg(values))
+}
+
+func (b *whereBuilder) compare(comparison JobComparison) error {
+	sqlType, ok := ComparableFields[comparison.Field]
+	if !ok {
+		return fmt.Errorf("cannot compare jobs on %q", comparison.Field)
+	}
+	op, ok := sqlOperators[comparison.Op]
+	if !ok {
+		return fmt.Errorf("unknown comparison operator %q", comparison.Op)
+	}
+
+	switch value := comparison.Value.(type) {
+	case time.Duration:
+		b.add("%s %s now() - make_interval(secs => %s)", comparison.Field, op, b.arg(value.Seconds()))
+	default:
+		b.add("%s %s %s::%s", comparison.Field, op, b.arg(value), sqlType)
+	}
+	return nil
+}
+
+func (b *whereBuilder) matchJSON(match JSONMatch) error {
+	if match.Column != "args" && match.Column != "metadata" {
+		return fmt.Errorf("cannot match JSON in %q", match.Column)
+	}
+	alternatives := make([]string, len(match.Values))
+	for i, value := range match.Values {
+		document, err := match.Document(value)
+		if err != nil {
+			return err
+		}
+		alternatives[i] = fmt.Sprintf("%s @> %s::jsonb", match.Column, b.arg(string(document)))
+	}
+	b.add("(%s)", strings.Join(alternatives, " OR "))
+	return nil
+}
+
+func (b *whereBuilder) matchText(match TextMatch) error {
+	pattern := b.arg("%" + likeEscaper.Replace(match.Text) + "%")
+	alternatives := make([]string, len(match.Columns))
+	for i, column := range match.Columns {
+		switch {
+		case column == "errors" && len(match.Path) == 0:
+			alternatives[i] = fmt.Sprintf("EXISTS (SELECT 1 FROM unnest(errors) AS attempt_error WHERE attempt_error->>'error' ILIKE %s)", pattern)
+		case (column == "args" || column == "metadata") && len(match.Path) > 0:
+			alternatives[i] = fmt.Sprintf("%s #>> %s::text[] ILIKE %s", column, b.arg(match.Path), pattern)
+		case column == "args" || column == "metadata":
+			alternatives[i] = fmt.Sprintf("%s::text ILIKE %s", column, pattern)
+		default:
+			return fmt.Errorf("cannot search text in %q", column)
+		}
+	}
+	b.add("(%s)", strings.Join(alternatives, " OR "))
+	return nil
+}
+
+// where returns the WHERE clause of the conditions, empty when there are none
+func (b *whereBuilder) where() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(b.conditions, " AND ")
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// JobSortField is a column the job list can be sorted by
+type JobSortField string
+
+const (
+	JobSortID          JobSortField = "id"
+	JobSortCreatedAt   JobSortField = "created_at"
+	JobSortScheduledAt JobSortField = "scheduled_at"
+	JobSortAttemptedAt JobSortField = "attempted_at"
+	JobSortFinalizedAt JobSortField = "finalized_at"
+	JobSortKind        JobSortField = "kind"
+	JobSortQueue       JobSortField = "queue"
+	JobSortAttempt     JobSortField = "attempt"
+)
+
+// JobListQuery selects a page of jobs, by descending ID unless another sort
+// is given. Empty filters match everything.
+type JobListQuery struct {
+	States []rivertype.JobState
+	// Kinds, ExcludeKinds and Queues may contain * wildcards
+	Kinds        []string
+	ExcludeKinds []string
+	Queues       []string
+	// Tags matches jobs having any of the tags
+	Tags        []string
+	Comparisons []JobComparison
+	JSONMatches []JSONMatch
+	TextMatches []TextMatch
+	// SortField orders the jobs, with the ID breaking ties. Jobs without a
+	// value for the field come last in either direction.
+	SortField JobSortField
+	Ascending bool
+	// After is the last job of the previous page, nil for the first page
+	After *rivertype.JobRow
+	Limit int
+}
+
+// jobSortColumn describes how a sort field is compared in SQL
+type jobSortColumn struct {
+	sqlType  string
+	nullable bool
+	value    func(job *rivertype.JobRow) any
+	// field points at the column in a job, to read it back from a cursor
+	field func(job *rivertype.JobRow) any
+}
+
+var jobSortColumns = map[JobSortField]jobSortColumn{
+	JobSortCreatedAt: {"timestamptz", false,
+		func(job *rivertype.JobRow) any { return job.CreatedAt },
+		func(job *rivertype.JobRow) any { return &job.CreatedAt }},
+	JobSortScheduledAt: {"timestamptz", false,
+		func(job *rivertype.JobRow) any { return job.ScheduledAt },
+		func(job *rivertype.JobRow) any { return &job.ScheduledAt }},
+	JobSortAttemptedAt: {"timestamptz", true,
+		func(job *rivertype.JobRow) any { return job.AttemptedAt },
+		func(job *rivertype.JobRow) any { return &job.AttemptedAt }},
+	JobSortFinalizedAt: {"timestamptz", true,
+		func(job *rivertype.JobRow) any { return job.FinalizedAt },
+		func(job *rivertype.JobRow) any { return &job.FinalizedAt }},
+	JobSortKind: {"text", false,
+		func(job *rivertype.JobRow) any { return job.Kind },
+		func(job *rivertype.JobRow) any { return &job.Kind }},
+	JobSortQueue: {"text", false,
+		func(job *rivertype.JobRow) any { return job.Queue },
+		func(job *rivertype.JobRow) any { return &job.Queue }},
+	JobSortAttempt: {"smallint", false,
+		func(job *rivertype.JobRow) any { return job.Attempt },
+		func(job *rivertype.JobRow) any { return &job.Attempt }},
+}
+
+// JobListCursor is where a page of the job list ends. It holds both keyset
+// columns of the last job, the sort value and the ID, so that the next page
+// can start after it in the same order. Its text form is opaque.
+type JobListCursor struct {
+	SortField JobSortField
+	Ascending bool
+	// Job is the last job of the page, with only the ID and the sort column set
+	// when read back from text
+	Job *rivertype.JobRow
+}
+
+// cursorText is the JSON encoded in the text form of a cursor
+type cursorText struct {
+	Sort      JobSortField    `json:"sort"`
+	Ascending bool            `json:"asc,omitempty"`
+	Value     json.RawMessage `json:"value,omitempty"`
+	ID        int64           `json:"id"`
+}
+
+// JobListCursorFromJob returns a cursor starting after the job in the given order
+func JobListCursorFromJob(job *rivertype.JobRow, sortField JobSortField, ascending bool) *JobListCursor {
+	if sortField == "" {
+		sortField = JobSortID
+	}
+	return &JobListCursor{SortField: sortField, Ascending: ascending, Job: job}
+}
+
+// MarshalText encodes the cursor as URL-safe base64 JSON
+func (c *JobListCursor) MarshalText() ([]byte, error) {
+	text := cursorText{Sort: c.SortField, Ascending: c.Ascending, ID: c.Job.ID}
+	if c.SortField != JobSortID {
+		col, ok := jobSortColumns[c.SortField]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort field %q", c.SortField)
+		}
+		value, err := json.Marshal(col.value(c.Job))
+		if err != nil {
+			return nil, err
+		}
+		text.Value = value
+	}
+
+	data, err := json.Marshal(text)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(base64.RawURLEncoding.EncodeToString(data)), nil
+}
+
+// UnmarshalText decodes a cursor written by MarshalText
+func (c *JobListCursor) UnmarshalText(data []byte) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid job list cursor")
+	}
+	var text cursorText
+	if err := json.Unmarshal(decoded, &text); err != nil || text.ID == 0 {
+		return fmt.Errorf("invalid job list cursor")
+	}
+
+	job := &rivertype.JobRow{ID: text.ID}
+	if text.Sort != JobSortID {
+		col, ok := jobSortColumns[text.Sort]
+		if !ok {
+			return fmt.Errorf("job list cursor has unknown sort field %q", text.Sort)
+		}
+		if err := json.Unmarshal(text.Value, col.field(job)); err != nil {
+			return fmt.Errorf("job list cursor has an invalid %s value", text.Sort)
+		}
+	}
+	*c = JobListCursor{SortField: text.Sort, Ascending: text.Ascending, Job: job}
+	return nil
+}
+
+// key returns the expression sorted on, replacing nulls with a value that
+// sorts after every other one
+func (col jobSortColumn) key(expr string, ascending bool) string {
+	if !col.nullable {
+		return expr
+	}
+	last := "'-infinity'"
+	if ascending {
+		last = "'infinity'"
+	}
+	return fmt.Sprintf("coalesce(%s, %s::%s)", expr, last, col.sqlType)
+}
+
+// JobList returns the jobs matching the query. Rows are selected with River's
+// own column list and scanned by its driver, so they match River's JobRow.
+func (c *Client) JobList(ctx context.Context, query JobListQuery) ([]*rivertype.JobRow, error) {
+	if query.Limit < 1 {
+		return nil, fmt.Errorf("job list limit must be greater than zero")
+	}
+	if query.SortField == "" {
+		query.SortField = JobSortID
+	}
+
+	b := &whereBuilder{args: pgx.NamedArgs{}}
+	if len(query.States) > 0 {
+		states := make([]string, len(query.States))
+		for i, state := range query.States {
+			states[i] = string(state)
+		}
+		b.add("state = any(%s::text[]::river_job_state[])", b.arg(states))
+	}
+	if len(query.Kinds) > 0 {
+		b.matchAny("kind", query.Kinds)
+	}
+	if len(query.ExcludeKinds) > 0 {
+		b.matchNone("kind", query.ExcludeKinds)
+	}
+	if len(query.Queues) > 0 {
+		b.matchAny("queue", query.Queues)
+	}
+	if len(query.Tags) > 0 {
+		b.add("tags && %s::varchar[]", b.arg(query.Tags))
+	}
+	for _, comparison := range query.Comparisons {
+		if err := b.compare(comparison); err != nil {
+			return nil, err
+		}
+	}
+	for _, match := range query.JSONMatches {
+		if err := b.matchJSON(match); err != nil {
+			return nil, err
+		}
+	}
+	for _, match := range query.TextMatches {
+		if err := b.matchText(match); err != nil {
+			return nil, err
+		}
+	}
+
+	direction, comparison := "DESC", "<"
+	if query.Ascending {
+		direction, comparison = "ASC", ">"
+	}
+
+	orderBy := "id " + direction
+	if query.SortField != JobSortID {
+		col, ok := jobSortColumns[query.SortField]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort field %q", query.SortField)
+		}
+		key := col.key(string(query.SortField), query.Ascending)
+		orderBy = fmt.Sprintf("%s %s, id %s", key, direction, direction)
+		if query.After != nil {
+			cursorKey := col.key(b.arg(col.value(query.After))+"::"+col.sqlType, query.Ascending)
+			b.add("(%s, id) %s (%s, %s)", key, comparison, cursorKey, b.arg(query.After.ID))
+		}
+	} else if query.After != nil {
+		b.add("id %s %s", comparison, b.arg(query.After.ID))
+	}
+
+	executor := riverpgxv5.New(c.Pool).GetExecutor()
+	sql := fmt.Sprintf("SELECT %s FROM river_job %s ORDER BY %s LIMIT %s",
+		executor.JobListFields(), b.where(), orderBy, b.arg(query.Limit))
+	return executor.JobList(ctx, sql, b.args)
+}
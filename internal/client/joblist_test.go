@@ -0,0 +1,55 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river/rivertype"
+)
+
+func TestJobListCursorRoundTrip(t *testing.T) {
+	attempted := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	job := &rivertype.JobRow{ID: 42, Kind: "report.build", Queue: "reports", Attempt: 3, AttemptedAt: &attempted}
+
+	tests := []struct {
+		name      string
+		field     JobSortField
+		ascending bool
+		check     func(*rivertype.JobRow) bool
+	}{
+		{"id", JobSortID, false, func(*rivertype.JobRow) bool { return true }},
+		{"kind", JobSortKind, true, func(got *rivertype.JobRow) bool { return got.Kind == job.Kind }},
+		{"attempt", JobSortAttempt, false, func(got *rivertype.JobRow) bool { return got.Attempt == job.Attempt }},
+		{"attempted at", JobSortAttemptedAt, true, func(got *rivertype.JobRow) bool {
+			return got.AttemptedAt != nil && got.AttemptedAt.Equal(attempted)
+		}},
+		{"missing finalized at", JobSortFinalizedAt, false, func(got *rivertype.JobRow) bool { return got.FinalizedAt == nil }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := JobListCursorFromJob(job, tt.field, tt.ascending).MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var cursor JobListCursor
+			if err := cursor.UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+			if cursor.SortField != tt.field || cursor.Ascending != tt.ascending || cursor.Job.ID != job.ID {
+				t.Errorf("got cursor %s %v after job %d", cursor.SortField, cursor.Ascending, cursor.Job.ID)
+			}
+			if !tt.check(cursor.Job) {
+				t.Errorf("the %s of job %+v was not kept", tt.field, cursor.Job)
+			}
+		})
+	}
+}
+
+func TestJobListCursorInvalid(t *testing.T) {
+	for _, text := range []string{"", "12345", "not base64!", "eyJzb3J0IjoiaWQifQ"} {
+		var cursor JobListCursor
+		if err := cursor.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("cursor %q was accepted", text)
+		}
+	}
+}
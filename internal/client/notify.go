@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ChangeChannels are the notification channels River publishes on when jobs
+// are inserted and when jobs or queues are controlled (cancel, pause, resume)
+var ChangeChannels = []string{"river_insert", "river_control"}
+
+// Subscription delivers change notifications until closed
+type Subscription interface {
+	// Wait blocks until a notification arrives and returns its channel
+	Wait(ctx context.Context) (string, error)
+	Close()
+}
+
+// Listener receives Postgres notifications on a dedicated connection
+type Listener struct {
+	conn *pgx.Conn
+}
+
+// Listen opens a dedicated connection outside the pool and subscribes to the channels
+func (c *Client) Listen(ctx context.Context, channels ...string) (Subscription, error) {
+	conn, err := pgx.ConnectConfig(ctx, c.Pool.Config().ConnConfig.Copy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open listener connection: %w", err)
+	}
+
+	for _, channel := range channels {
+		if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			conn.Close(context.Background())
+			return nil, fmt.Errorf("failed to listen on %s: %w", channel, err)
+		}
+	}
+
+	return &Listener{conn: conn}, nil
+}
+
+// Wait blocks until a notification arrives and returns its channel
+func (l *Listener) Wait(ctx context.Context) (string, error) {
+	notification, err := l.conn.WaitForNotification(ctx)
+	if err != nil {
+		return "", err
+	}
+	return notification.Channel, nil
+}
+
+// Close closes the listener connection
+func (l *Listener) Close() {
+	l.conn.Close(context.Background())
+}
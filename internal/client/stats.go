@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river/rivertype"
+)
+
+// StateCount is the number of jobs in a given state for a queue and kind
+type StateCount struct {
+	Queue string
+	Kind  string
+	State rivertype.JobState
+	Count int64
+}
+
+// Throughput is the number of jobs that completed or failed within a time window
+type Throughput struct {
+	Window    time.Duration
+	Completed int64
+	Failed    int64
+}
+
+// QueueLatency is how long the oldest available job of a queue has been waiting
+type QueueLatency struct {
+	Queue           string
+	OldestAvailable time.Duration
+}
+
+// JobCounts returns job counts grouped by queue, kind and state
+func (c *Client) JobCounts(ctx context.Context) ([]StateCount, error) {
+	rows, err := c.Pool.Query(ctx, `
+		SELECT queue, kind, state, count(*)
+		FROM river_job
+		GROUP BY queue, kind, state`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []StateCount
+	for rows.Next() {
+		var count StateCount
+		var state string
+		if err := rows.Scan(&count.Queue, &count.Kind, &state, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan job count: %w", err)
+		}
+		count.State = rivertype.JobState(state)
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+// JobNames are the distinct kinds and queues of the jobs in the table, in name order
+type JobNames struct {
+	Kinds  []string
+	Queues []string
+}
+
+// JobNames returns the kinds and queues used by jobs
+func (c *Client) JobNames(ctx context.Context) (JobNames, error) {
+	rows, err := c.Pool.Query(ctx, `
+		SELECT 'kind', kind FROM (SELECT DISTINCT kind FROM river_job) AS kinds
+		UNION ALL
+		SELECT 'queue', queue FROM (SELECT DISTINCT queue FROM river_job) AS queues
+		ORDER BY 1, 2`)
+	if err != nil {
+		return JobNames{}, fmt.Errorf("failed to list job names: %w", err)
+	}
+	defer rows.Close()
+
+	var names JobNames
+	for rows.Next() {
+		var column, name string
+		if err := rows.Scan(&column, &name); err != nil {
+			return JobNames{}, fmt.Errorf("failed to scan job name: %w", err)
+		}
+		if column == "kind" {
+			names.Kinds = append(names.Kinds, name)
+		} else {
+			names.Queues = append(names.Queues, name)
+		}
+	}
+	return names, rows.Err()
+}
+
+// JobThroughput returns completed and failed job counts for each window ending now.
+// Failed jobs are those discarded, or that errored and are waiting for a retry.
+// Every window is counted in a single scan of the jobs within the largest one.
+func (c *Client) JobThroughput(ctx context.Context, windows []time.Duration) ([]Throughput, error) {
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	counts := make([]string, 0, 2*len(windows))
+	args := make([]any, 0, len(windows)+1)
+	largest := windows[0]
+	for i, window := range windows {
+		since := fmt.Sprintf("now() - make_interval(secs => $%d)", i+1)
+		counts = append(counts,
+			fmt.Sprintf("count(*) FILTER (WHERE state = 'completed' AND finalized_at >= %s)", since),
+			fmt.Sprintf("count(*) FILTER (WHERE (state = 'discarded' AND finalized_at >= %[1]s) OR (state = 'retryable' AND attempted_at >= %[1]s))", since))
+		args = append(args, window.Seconds())
+		largest = max(largest, window)
+	}
+	args = append(args, largest.Seconds())
+
+	throughput := make([]Throughput, len(windows))
+	dest := make([]any, 0, 2*len(windows))
+	for i, window := range windows {
+		throughput[i].Window = window
+		dest = append(dest, &throughput[i].Completed, &throughput[i].Failed)
+	}
+
+	bound := fmt.Sprintf("now() - make_interval(secs => $%d)", len(args))
+	query := fmt.Sprintf("SELECT %s FROM river_job WHERE finalized_at >= %s OR attempted_at >= %s",
+		strings.Join(counts, ", "), bound, bound)
+	if err := c.Pool.QueryRow(ctx, query, args...).Scan(dest...); err != nil {
+		return nil, fmt.Errorf("failed to compute throughput: %w", err)
+	}
+	return throughput, nil
+}
+
+// QueueLatencies returns the age of the oldest available job for each queue with available jobs
+func (c *Client) QueueLatencies(ctx context.Context) ([]QueueLatency, error) {
+	rows, err := c.Pool.Query(ctx, `
+		SELECT queue, EXTRACT(EPOCH FROM now() - min(scheduled_at))::float8
+		FROM river_job
+		WHERE state = 'available'
+		GROUP BY queue
+		ORDER BY queue`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute queue latency: %w", err)
+	}
+	defer rows.Close()
+
+	var latencies []QueueLatency
+	for rows.Next() {
+		var latency QueueLatency
+		var seconds float64
+		if err := rows.Scan(&latency.Queue, &seconds); err != nil {
+			return nil, fmt.Errorf("failed to scan queue latency: %w", err)
+		}
+		latency.OldestAvailable = time.Duration(seconds * float64(time.Second))
+		latencies = append(latencies, latency)
+	}
+	return latencies, rows.Err()
+}
+
+// Series metrics
+const (
+	MetricEnqueued  = "enqueued"
+	MetricCompleted = "completed"
+	MetricErrored   = "errored"
+	MetricDiscarded = "discarded"
+)
+
+// SeriesPoint is the number of events of a metric for one kind within one time bucket.
+// Bucket 0 starts at the beginning of the window.
+type SeriesPoint struct {
+	Metric string
+	Kind   string
+	Bucket int
+	Count  int64
+}
+
+// JobSeries returns job events over the window ending now, counted per metric, kind and bucket.
+// Errored counts every failed attempt recorded in the jobs' errors.
+func (c *Client) JobSeries(ctx context.Context, window, bucket time.Duration) ([]SeriesPoint, error) {
+	rows, err := c.Pool.Query(ctx, `
+		WITH params AS (
+			SELECT now() - make_interval(secs => $1) AS since
+		), events AS (
+			SELECT 'enqueued' AS metric, kind, created_at AS at
+			FROM river_job, params WHERE created_at >= since
+			UNION ALL
+			SELECT 'completed', kind, finalized_at
+			FROM river_job, params WHERE state = 'completed' AND finalized_at >= since
+			UNION ALL
+			SELECT 'discarded', kind, finalized_at
+			FROM river_job, params WHERE state = 'discarded' AND finalized_at >= since
+			UNION ALL
+			SELECT 'errored', kind, (attempt_error->>'at')::timestamptz
+			FROM river_job, params, unnest(errors) AS attempt_error
+			WHERE attempted_at >= since AND (attempt_error->>'at')::timestamptz >= since
+		)
+		SELECT metric, kind, floor(EXTRACT(EPOCH FROM at - since) / $2)::int AS bucket, count(*)
+		FROM events, params
+		GROUP BY metric, kind, bucket`,
+		window.Seconds(), bucket.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job series: %w", err)
+	}
+	defer rows.Close()
+
+	var points []SeriesPoint
+	for rows.Next() {
+		var point SeriesPoint
+		if err := rows.Scan(&point.Metric, &point.Kind, &point.Bucket, &point.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan job series: %w", err)
+		}
+		points = append(points, point)
+	}
+	return points, rows.Err()
+}
+
+// RetryCount is the number of retries made by the jobs of a queue and kind
+type RetryCount struct {
+	Queue   string
+	Kind    string
+	Retries int64
+}
+
+// JobRetries returns, per queue and kind, the attempts made beyond the first by jobs still in the table
+func (c *Client) JobRetries(ctx context.Context) ([]RetryCount, error) {
+	rows, err := c.Pool.Query(ctx, `
+		SELECT queue, kind, sum(attempt - 1)::bigint
+		FROM river_job
+		WHERE attempt > 1
+		GROUP BY queue, kind`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count retries: %w", err)
+	}
+	defer rows.Close()
+
+	var retries []RetryCount
+	for rows.Next() {
+		var retry RetryCount
+		if err := rows.Scan(&retry.Queue, &retry.Kind, &retry.Retries); err != nil {
+			return nil, fmt.Errorf("failed to scan retry count: %w", err)
+		}
+		retries = append(retries, retry)
+	}
+	return retries, rows.Err()
+}
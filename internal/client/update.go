@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/riverqueue/river/rivertype"
+)
+
+// ErrJobNotPending is returned when trying to update a job that is no longer waiting to run
+var ErrJobNotPending = errors.New("only available, scheduled and retryable jobs can be updated")
+
+// PendingStates are the states of the jobs that can be rescheduled, reprioritized or moved
+var PendingStates = []rivertype.JobState{
+	rivertype.JobStateAvailable,
+	rivertype.JobStateScheduled,
+	rivertype.JobStateRetryable,
+}
+
+// JobUpdateParams describe changes to a pending job. Zero values leave the
+// job unchanged. At most one of ScheduledAt and Postpone may be set.
+type JobUpdateParams struct {
+	// ScheduledAt is when to run the job, and runs it right away when not after now
+	ScheduledAt time.Time
+	// Postpone delays the job from its scheduled time, or from now if that has passed
+	Postpone time.Duration
+	Priority int
+	Queue    string
+}
+
+// IsZero reports whether the params change nothing
+func (p JobUpdateParams) IsZero() bool {
+	return p.ScheduledAt.IsZero() && p.Postpone == 0 && p.Priority == 0 && p.Queue == ""
+}
+
+// Validate checks the params before applying them
+func (p JobUpdateParams) Validate() error {
+	if p.IsZero() {
+		return errors.New("nothing to update")
+	}
+	if !p.ScheduledAt.IsZero() && p.Postpone != 0 {
+		return errors.New("a job can't be both scheduled and postponed")
+	}
+	if p.Postpone < 0 {
+		return fmt.Errorf("postpone duration must be positive, got %s", p.Postpone)
+	}
+	if p.Priority < 0 || p.Priority > 4 {
+		return fmt.Errorf("priority must be between 1 and 4, got %d", p.Priority)
+	}
+	return nil
+}
+
+// jobUpdateQuery applies JobUpdateParams to the pending jobs among $1.
+// Rescheduling makes a job available when its new time has come, and
+// otherwise scheduled, unless it is retryable, which River's scheduler
+// treats the same.
+const jobUpdateQuery = `
+UPDATE river_job
+SET
+	scheduled_at = updated.scheduled_at,
+	state = CASE
+		WHEN $2::timestamptz IS NULL AND $3::bigint = 0 THEN river_job.state
+		WHEN updated.scheduled_at <= now() THEN 'available'::river_job_state
+		WHEN river_job.state = 'retryable' THEN 'retryable'::river_job_state
+		ELSE 'scheduled'::river_job_state
+	END,
+	priority = coalesce($4::smallint, river_job.priority),
+	queue = coalesce($5::text, river_job.queue)
+FROM (
+	SELECT id, CASE
+		WHEN $2::timestamptz IS NOT NULL THEN $2::timestamptz
+		WHEN $3::bigint > 0 THEN greatest(scheduled_at, now()) + $3::bigint * interval '1 microsecond'
+		ELSE scheduled_at
+	END AS scheduled_at
+	FROM river_job
+	WHERE id = any($1::bigint[])
+) AS updated
+WHERE river_job.id = updated.id AND river_job.state IN ('available', 'scheduled', 'retryable')
+RETURNING river_job.id`
+
+// queryArgs returns the arguments of jobUpdateQuery after the job IDs
+func (p JobUpdateParams) queryArgs() []any {
+	var scheduledAt, priority, queue any
+	if !p.ScheduledAt.IsZero() {
+		scheduledAt = p.ScheduledAt
+	}
+	if p.Priority != 0 {
+		priority = p.Priority
+	}
+	if p.Queue != "" {
+		queue = p.Queue
+	}
+	return []any{scheduledAt, p.Postpone.Microseconds(), priority, queue}
+}
+
+// JobUpdate reschedules, reprioritizes or moves a pending job and returns it
+// as updated. Jobs in other states are refused with ErrJobNotPending.
+func (c *Client) JobUpdate(ctx context.Context, id int64, params JobUpdateParams) (*rivertype.JobRow, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := c.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	job, err := c.RiverClient.JobGetTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(PendingStates, job.State) {
+		return nil, fmt.Errorf("%w, job %d is %s", ErrJobNotPending, id, job.State)
+	}
+
+	// Guard against the job having started between the read and the update
+	tag, err := tx.Exec(ctx, jobUpdateQuery, append([]any{[]int64{id}}, params.queryArgs()...)...)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, fmt.Errorf("%w, job %d changed state meanwhile", ErrJobNotPending, id)
+	}
+
+	job, err = c.RiverClient.JobGetTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -8,8 +8,9 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// Connect establishes a connection to the PostgreSQL database
-func Connect(ctx context.Context, dbURL string, timeout time.Duration) (*pgxpool.Pool, error) {
+// Connect establishes a connection to the PostgreSQL database. When readOnly is
+// set, every session defaults to read-only transactions.
+func Connect(ctx context.Context, dbURL string, timeout time.Duration, readOnly bool) (*pgxpool.Pool, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
@@ -22,6 +23,9 @@ func Connect(ctx context.Context, dbURL string, timeout time.Duration) (*pgxpool
 	config.MinConns = 1
 	config.MaxConnLifetime = time.Hour
 	config.MaxConnIdleTime = 30 * time.Minute
+	if readOnly {
+		config.ConnConfig.RuntimeParams["default_transaction_read_only"] = "on"
+	}
 
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
@@ -0,0 +1,213 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+)
+
+const maxQueues = 10000
+
+// Exporter periodically collects River metrics and serves the latest snapshot
+// in the Prometheus text exposition format
+type Exporter struct {
+	client   *client.Client
+	interval time.Duration
+	logger   *log.Logger
+
+	mu          sync.RWMutex
+	metrics     []byte
+	success     bool
+	duration    time.Duration
+	collectedAt time.Time
+}
+
+// New creates an exporter that collects metrics through the client every interval
+func New(cli *client.Client, interval time.Duration, logger *log.Logger) *Exporter {
+	return &Exporter{
+		client:   cli,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run collects metrics immediately and then every interval until the context is done
+func (e *Exporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update collects a snapshot, keeping the previous metrics if collection fails
+func (e *Exporter) update(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, e.interval)
+	defer cancel()
+
+	start := time.Now()
+	metrics, err := e.collect(ctx)
+	duration := time.Since(start)
+	if err != nil && ctx.Err() == nil {
+		e.logger.Printf("failed to collect metrics: %v", err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.success = err == nil
+	e.duration = duration
+	if err == nil {
+		e.metrics = metrics
+		e.collectedAt = time.Now()
+	}
+}
+
+// collect queries River tables and renders the job and queue metrics
+func (e *Exporter) collect(ctx context.Context) ([]byte, error) {
+	counts, err := e.client.JobCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retries, err := e.client.JobRetries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latencies, err := e.client.QueueLatencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queues, err := e.client.QueueList(ctx, maxQueues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	var w metricsWriter
+
+	sort.Slice(counts, func(i, j int) bool {
+		a, b := counts[i], counts[j]
+		if a.Queue != b.Queue {
+			return a.Queue < b.Queue
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.State < b.State
+	})
+	w.family("river_jobs", "Number of jobs by queue, kind and state.", "gauge")
+	for _, count := range counts {
+		w.sample("river_jobs", float64(count.Count), "queue", count.Queue, "kind", count.Kind, "state", string(count.State))
+	}
+
+	sort.Slice(retries, func(i, j int) bool {
+		if retries[i].Queue != retries[j].Queue {
+			return retries[i].Queue < retries[j].Queue
+		}
+		return retries[i].Kind < retries[j].Kind
+	})
+	w.family("river_job_retries", "Attempts beyond the first made by jobs still in the table, by queue and kind.", "gauge")
+	for _, retry := range retries {
+		w.sample("river_job_retries", float64(retry.Retries), "queue", retry.Queue, "kind", retry.Kind)
+	}
+
+	// Report every known queue, with zero age when nothing is waiting
+	oldest := make(map[string]time.Duration)
+	for _, queue := range queues {
+		oldest[queue.Name] = 0
+	}
+	for _, latency := range latencies {
+		oldest[latency.Queue] = latency.OldestAvailable
+	}
+	w.family("river_queue_oldest_available_job_age_seconds", "Age of the oldest available job in each queue.", "gauge")
+	for _, name := range sortedKeys(oldest) {
+		w.sample("river_queue_oldest_available_job_age_seconds", oldest[name].Seconds(), "queue", name)
+	}
+
+	sort.Slice(queues, func(i, j int) bool { return queues[i].Name < queues[j].Name })
+	w.family("river_queue_paused", "Whether the queue is paused (1) or not (0).", "gauge")
+	for _, queue := range queues {
+		paused := 0.0
+		if queue.PausedAt != nil {
+			paused = 1
+		}
+		w.sample("river_queue_paused", paused, "queue", queue.Name)
+	}
+
+	return w.buf.Bytes(), nil
+}
+
+// ServeHTTP writes the latest snapshot followed by the exporter's own metrics
+func (e *Exporter) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	e.mu.RLock()
+	var w metricsWriter
+	w.buf.Write(e.metrics)
+
+	success := 0.0
+	if e.success {
+		success = 1
+	}
+	w.family("river_exporter_collect_success", "Whether the last collection succeeded.", "gauge")
+	w.sample("river_exporter_collect_success", success)
+	w.family("river_exporter_collect_duration_seconds", "Duration of the last collection.", "gauge")
+	w.sample("river_exporter_collect_duration_seconds", e.duration.Seconds())
+	if !e.collectedAt.IsZero() {
+		w.family("river_exporter_last_success_timestamp_seconds", "Unix time of the last successful collection.", "gauge")
+		w.sample("river_exporter_last_success_timestamp_seconds", float64(e.collectedAt.UnixMilli())/1000)
+	}
+	e.mu.RUnlock()
+
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	rw.Write(w.buf.Bytes())
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+// metricsWriter builds a Prometheus text exposition
+type metricsWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *metricsWriter) family(name, help, metricType string) {
+	fmt.Fprintf(&w.buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// sample writes one sample; labels are name/value pairs
+func (w *metricsWriter) sample(name string, value float64, labels ...string) {
+	w.buf.WriteString(name)
+	if len(labels) > 0 {
+		w.buf.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			fmt.Fprintf(&w.buf, `%s="%s"`, labels[i], labelEscaper.Replace(labels[i+1]))
+		}
+		w.buf.WriteByte('}')
+	}
+	w.buf.WriteByte(' ')
+	w.buf.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+	w.buf.WriteByte('\n')
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,917 @@
+// Package memory implements the monitor's data source in memory, so the
+// monitor can be driven without a database
+package memory
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// Source holds jobs and queues in memory and mimics River's behaviour for the
+// operations the monitor performs on them. It is safe for concurrent use.
+type Source struct {
+	mu          sync.Mutex
+	nextID      int64
+	jobs        map[int64]*rivertype.JobRow
+	queues      map[string]*rivertype.Queue
+	subscribers map[*subscription]struct{}
+}
+
+// NewSource creates an empty source
+func NewSource() *Source {
+	return &Source{
+		jobs:        make(map[int64]*rivertype.JobRow),
+		queues:      make(map[string]*rivertype.Queue),
+		subscribers: make(map[*subscription]struct{}),
+	}
+}
+
+// AddJob stores a copy of the job and returns it. Jobs without an ID get the
+// next free one, and missing fields get the defaults River would insert with.
+func (s *Source) AddJob(job *rivertype.JobRow) *rivertype.JobRow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *job
+	if stored.ID == 0 {
+		stored.ID = s.nextID + 1
+	}
+	s.nextID = max(s.nextID, stored.ID)
+	now := time.Now()
+	if stored.CreatedAt.IsZero() {
+		stored.CreatedAt = now
+	}
+	if stored.ScheduledAt.IsZero() {
+		stored.ScheduledAt = stored.CreatedAt
+	}
+	if stored.State == "" {
+		stored.State = rivertype.JobStateAvailable
+	}
+	if stored.Queue == "" {
+		stored.Queue = "default"
+	}
+	if stored.MaxAttempts == 0 {
+		stored.MaxAttempts = 25
+	}
+	if stored.EncodedArgs == nil {
+		stored.EncodedArgs = []byte("{}")
+	}
+	s.jobs[stored.ID] = &stored
+	if _, ok := s.queues[stored.Queue]; !ok {
+		s.queues[stored.Queue] = &rivertype.Queue{Name: stored.Queue, CreatedAt: now, UpdatedAt: now}
+	}
+
+	s.notify("river_insert")
+	return copyJob(&stored)
+}
+
+// AddQueue registers a queue, which otherwise appears with its first job
+func (s *Source) AddQueue(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.queues[name]; !ok {
+		now := time.Now()
+		s.queues[name] = &rivertype.Queue{Name: name, CreatedAt: now, UpdatedAt: now}
+	}
+}
+
+// Notify delivers a change notification on the channel to every subscription
+func (s *Source) Notify(channel string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notify(channel)
+}
+
+// JobList returns the jobs matching the query in the order it asks for
+func (s *Source) JobList(ctx context.Context, query client.JobListQuery) ([]*rivertype.JobRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matcher := newJobMatcher(query)
+	var jobs []*rivertype.JobRow
+	for _, job := range s.jobs {
+		match, err := matcher.match(job)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+		if query.After != nil && !jobBefore(query.After, job, query.SortField, query.Ascending) {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobBefore(jobs[i], jobs[j], query.SortField, query.Ascending)
+	})
+
+	if query.Limit > 0 && len(jobs) > query.Limit {
+		jobs = jobs[:query.Limit]
+	}
+	for i, job := range jobs {
+		jobs[i] = copyJob(job)
+	}
+	return jobs, nil
+}
+
+// JobGet returns a job by ID
+func (s *Source) JobGet(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, rivertype.ErrNotFound
+	}
+	return copyJob(job), nil
+}
+
+// JobRetry makes a job that isn't running available to run again
+func (s *Source) JobRetry(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, rivertype.ErrNotFound
+	}
+	retryJob(job, time.Now())
+	return copyJob(job), nil
+}
+
+// JobRetryMany makes the jobs that aren't running available to run again
+func (s *Source) JobRetryMany(ctx context.Context, ids []int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.applyMany(ids, retryJob), nil
+}
+
+// retryJob makes the job available unless it is running, and reports whether it did
+func retryJob(job *rivertype.JobRow, now time.Time) bool {
+	if job.State == rivertype.JobStateRunning {
+		return false
+	}
+	job.State = rivertype.JobStateAvailable
+	job.FinalizedAt = nil
+	if job.ScheduledAt.After(now) {
+		job.ScheduledAt = now
+	}
+	if job.Attempt >= job.MaxAttempts {
+		job.MaxAttempts++
+	}
+	return true
+}
+
+// applyMany applies the change to the jobs among the IDs and returns how
+// many it changed
+func (s *Source) applyMany(ids []int64, change func(job *rivertype.JobRow, now time.Time) bool) int {
+	now := time.Now()
+	count := 0
+	for _, id := range ids {
+		if job, ok := s.jobs[id]; ok && change(job, now) {
+			count++
+		}
+	}
+	return count
+}
+
+// JobCancel cancels a job that is still waiting to run. Finalized and
+// running jobs are left unchanged, since only their worker can stop them.
+func (s *Source) JobCancel(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, rivertype.ErrNotFound
+	}
+	if cancelJob(job, time.Now()) {
+		s.notify("river_control")
+	}
+	return copyJob(job), nil
+}
+
+// JobCancelMany cancels the jobs still waiting to run
+func (s *Source) JobCancelMany(ctx context.Context, ids []int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := s.applyMany(ids, cancelJob)
+	if count > 0 {
+		s.notify("river_control")
+	}
+	return count, nil
+}
+
+// cancelJob cancels the job if it is waiting to run, and reports whether it did
+func cancelJob(job *rivertype.JobRow, now time.Time) bool {
+	if !slices.Contains(client.PendingStates, job.State) {
+		return false
+	}
+	job.State = rivertype.JobStateCancelled
+	job.FinalizedAt = &now
+	return true
+}
+
+// JobDelete deletes a job that is not running and returns it as it was before deletion
+func (s *Source) JobDelete(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, rivertype.ErrNotFound
+	}
+	if job.State == rivertype.JobStateRunning {
+		return nil, client.ErrJobRunning
+	}
+	delete(s.jobs, id)
+	return copyJob(job), nil
+}
+
+// JobDeleteMany deletes the jobs that aren't running
+func (s *Source) JobDeleteMany(ctx context.Context, ids []int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.applyMany(ids, func(job *rivertype.JobRow, now time.Time) bool {
+		if job.State == rivertype.JobStateRunning {
+			return false
+		}
+		delete(s.jobs, job.ID)
+		return true
+	}), nil
+}
+
+// JobUpdate reschedules, reprioritizes or moves a job waiting to run
+func (s *Source) JobUpdate(ctx context.Context, id int64, params client.JobUpdateParams) (*rivertype.JobRow, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, rivertype.ErrNotFound
+	}
+	if !slices.Contains(client.PendingStates, job.State) {
+		return nil, fmt.Errorf("%w, job %d is %s", client.ErrJobNotPending, id, job.State)
+	}
+
+	s.updateJob(job, params, time.Now())
+	return copyJob(job), nil
+}
+
+// JobUpdateMany reschedules, reprioritizes or moves the jobs still waiting to run
+func (s *Source) JobUpdateMany(ctx context.Context, ids []int64, params client.JobUpdateParams) (int, error) {
+	if err := params.Validate(); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.applyMany(ids, func(job *rivertype.JobRow, now time.Time) bool {
+		if !slices.Contains(client.PendingStates, job.State) {
+			return false
+		}
+		s.updateJob(job, params, now)
+		return true
+	}), nil
+}
+
+// updateJob applies the params to a pending job, adding the queue it moves to if new
+func (s *Source) updateJob(job *rivertype.JobRow, params client.JobUpdateParams, now time.Time) {
+	switch {
+	case !params.ScheduledAt.IsZero():
+		job.ScheduledAt = params.ScheduledAt
+	case params.Postpone > 0:
+		job.ScheduledAt = maxTime(job.ScheduledAt, now).Add(params.Postpone)
+	}
+	if !params.ScheduledAt.IsZero() || params.Postpone > 0 {
+		switch {
+		case !job.ScheduledAt.After(now):
+			job.State = rivertype.JobStateAvailable
+		case job.State != rivertype.JobStateRetryable:
+			job.State = rivertype.JobStateScheduled
+		}
+	}
+	if params.Priority != 0 {
+		job.Priority = params.Priority
+	}
+	if params.Queue != "" {
+		job.Queue = params.Queue
+		if _, ok := s.queues[job.Queue]; !ok {
+			s.queues[job.Queue] = &rivertype.Queue{Name: job.Queue, CreatedAt: now, UpdatedAt: now}
+		}
+	}
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// JobInsert inserts a job the way River would, skipping unique duplicates
+func (s *Source) JobInsert(ctx context.Context, params client.JobInsertParams) (*rivertype.JobInsertResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if !params.Unique.IsZero() {
+		if existing := s.findDuplicate(params, time.Now()); existing != nil {
+			return &rivertype.JobInsertResult{Job: existing, UniqueSkippedAsDuplicate: true}, nil
+		}
+	}
+	job := &rivertype.JobRow{
+		Kind:        params.Kind,
+		EncodedArgs: params.Args,
+		Queue:       params.Queue,
+		Priority:    params.Priority,
+		MaxAttempts: params.MaxAttempts,
+		Tags:        params.Tags,
+		Metadata:    params.Metadata,
+		ScheduledAt: params.ScheduledAt,
+	}
+	if job.Priority == 0 {
+		job.Priority = 1
+	}
+	if job.Tags == nil {
+		job.Tags = []string{}
+	}
+	if len(job.Metadata) == 0 {
+		job.Metadata = []byte("{}")
+	}
+	if !job.ScheduledAt.IsZero() {
+		job.State = rivertype.JobStateScheduled
+	}
+	return &rivertype.JobInsertResult{Job: s.AddJob(job)}, nil
+}
+
+// findDuplicate returns a copy of a job a unique insert of params would be skipped for
+func (s *Source) findDuplicate(params client.JobInsertParams, now time.Time) *rivertype.JobRow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	states := params.Unique.States
+	if len(states) == 0 {
+		states = client.DefaultUniqueStates
+	}
+	queue := cmp.Or(params.Queue, "default")
+	var args any
+	if params.Unique.Args {
+		_ = json.Unmarshal(params.Args, &args)
+	}
+	var periodStart time.Time
+	if params.Unique.Period > 0 {
+		periodStart = now.Truncate(params.Unique.Period)
+	}
+
+	for _, job := range s.jobs {
+		if job.Kind != params.Kind || !slices.Contains(states, job.State) {
+			continue
+		}
+		if params.Unique.Queue && job.Queue != queue {
+			continue
+		}
+		if params.Unique.Args {
+			var jobArgs any
+			if json.Unmarshal(job.EncodedArgs, &jobArgs) != nil || !reflect.DeepEqual(jobArgs, args) {
+				continue
+			}
+		}
+		if !periodStart.IsZero() && (job.CreatedAt.Before(periodStart) || !job.CreatedAt.Before(periodStart.Add(params.Unique.Period))) {
+			continue
+		}
+		return copyJob(job)
+	}
+	return nil
+}
+
+// QueueList returns up to limit queues ordered by name
+func (s *Source) QueueList(ctx context.Context, limit int) ([]*rivertype.Queue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queues := make([]*rivertype.Queue, 0, len(s.queues))
+	for _, queue := range s.queues {
+		copied := *queue
+		queues = append(queues, &copied)
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i].Name < queues[j].Name })
+
+	if limit > 0 && len(queues) > limit {
+		queues = queues[:limit]
+	}
+	return queues, nil
+}
+
+// QueuePause pauses a queue
+func (s *Source) QueuePause(ctx context.Context, name string) error {
+	return s.setPaused(name, true)
+}
+
+// QueueResume resumes a paused queue
+func (s *Source) QueueResume(ctx context.Context, name string) error {
+	return s.setPaused(name, false)
+}
+
+func (s *Source) setPaused(name string, paused bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, ok := s.queues[name]
+	if !ok {
+		return rivertype.ErrNotFound
+	}
+	now := time.Now()
+	queue.PausedAt = nil
+	if paused {
+		queue.PausedAt = &now
+	}
+	queue.UpdatedAt = now
+	s.notify("river_control")
+	return nil
+}
+
+// JobCounts returns job counts grouped by queue, kind and state
+func (s *Source) JobCounts(ctx context.Context) ([]client.StateCount, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type key struct {
+		queue, kind string
+		state       rivertype.JobState
+	}
+	totals := make(map[key]int64)
+	for _, job := range s.jobs {
+		totals[key{job.Queue, job.Kind, job.State}]++
+	}
+
+	counts := make([]client.StateCount, 0, len(totals))
+	for k, count := range totals {
+		counts = append(counts, client.StateCount{Queue: k.queue, Kind: k.kind, State: k.state, Count: count})
+	}
+	return counts, nil
+}
+
+// JobNames returns the kinds and queues used by jobs
+func (s *Source) JobNames(ctx context.Context) (client.JobNames, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var names client.JobNames
+	for _, job := range s.jobs {
+		names.Kinds = append(names.Kinds, job.Kind)
+		names.Queues = append(names.Queues, job.Queue)
+	}
+	slices.Sort(names.Kinds)
+	slices.Sort(names.Queues)
+	names.Kinds = slices.Compact(names.Kinds)
+	names.Queues = slices.Compact(names.Queues)
+	return names, nil
+}
+
+// JobThroughput returns completed and failed job counts for each window ending now
+func (s *Source) JobThroughput(ctx context.Context, windows []time.Duration) ([]client.Throughput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	throughput := make([]client.Throughput, 0, len(windows))
+	for _, window := range windows {
+		t := client.Throughput{Window: window}
+		since := now.Add(-window)
+		for _, job := range s.jobs {
+			finalized := job.FinalizedAt != nil && !job.FinalizedAt.Before(since)
+			attempted := job.AttemptedAt != nil && !job.AttemptedAt.Before(since)
+			switch {
+			case job.State == rivertype.JobStateCompleted && finalized:
+				t.Completed++
+			case job.State == rivertype.JobStateDiscarded && finalized,
+				job.State == rivertype.JobStateRetryable && attempted:
+				t.Failed++
+			}
+		}
+		throughput = append(throughput, t)
+	}
+	return throughput, nil
+}
+
+// QueueLatencies returns the age of the oldest available job for each queue with available jobs
+func (s *Source) QueueLatencies(ctx context.Context) ([]client.QueueLatency, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oldest := make(map[string]time.Time)
+	for _, job := range s.jobs {
+		if job.State != rivertype.JobStateAvailable {
+			continue
+		}
+		if at, ok := oldest[job.Queue]; !ok || job.ScheduledAt.Before(at) {
+			oldest[job.Queue] = job.ScheduledAt
+		}
+	}
+
+	now := time.Now()
+	latencies := make([]client.QueueLatency, 0, len(oldest))
+	for queue, at := range oldest {
+		latencies = append(latencies, client.QueueLatency{Queue: queue, OldestAvailable: now.Sub(at)})
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i].Queue < latencies[j].Queue })
+	return latencies, nil
+}
+
+// JobSeries returns job events over the window ending now, counted per metric, kind and bucket
+func (s *Source) JobSeries(ctx context.Context, window, bucket time.Duration) ([]client.SeriesPoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type key struct {
+		metric, kind string
+		bucket       int
+	}
+	since := time.Now().Add(-window)
+	totals := make(map[key]int64)
+	count := func(metric, kind string, at time.Time) {
+		if !at.Before(since) {
+			totals[key{metric, kind, int(at.Sub(since) / bucket)}]++
+		}
+	}
+
+	for _, job := range s.jobs {
+		count(client.MetricEnqueued, job.Kind, job.CreatedAt)
+		if job.FinalizedAt != nil {
+			switch job.State {
+			case rivertype.JobStateCompleted:
+				count(client.MetricCompleted, job.Kind, *job.FinalizedAt)
+			case rivertype.JobStateDiscarded:
+				count(client.MetricDiscarded, job.Kind, *job.FinalizedAt)
+			}
+		}
+		for _, attemptError := range job.Errors {
+			count(client.MetricErrored, job.Kind, attemptError.At)
+		}
+	}
+
+	points := make([]client.SeriesPoint, 0, len(totals))
+	for k, total := range totals {
+		points = append(points, client.SeriesPoint{Metric: k.metric, Kind: k.kind, Bucket: k.bucket, Count: total})
+	}
+	return points, nil
+}
+
+// Listen subscribes to the notifications sent on the channels
+func (s *Source) Listen(ctx context.Context, channels ...string) (client.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &subscription{
+		source:   s,
+		channels: slices.Clone(channels),
+		pending:  make(chan string, 16),
+	}
+	s.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// Close ends every subscription
+func (s *Source) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers {
+		delete(s.subscribers, sub)
+		close(sub.pending)
+	}
+}
+
+// notify must be called with the lock held
+func (s *Source) notify(channel string) {
+	for sub := range s.subscribers {
+		if !slices.Contains(sub.channels, channel) {
+			continue
+		}
+		select {
+		case sub.pending <- channel:
+		default:
+			// The subscriber is behind, it will refresh anyway
+		}
+	}
+}
+
+var errSubscriptionClosed = errors.New("subscription closed")
+
+type subscription struct {
+	source   *Source
+	channels []string
+	pending  chan string
+}
+
+// Wait blocks until a notification arrives and returns its channel
+func (sub *subscription) Wait(ctx context.Context) (string, error) {
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case channel, ok := <-sub.pending:
+		if !ok {
+			return "", errSubscriptionClosed
+		}
+		return channel, nil
+	}
+}
+
+// Close ends the subscription
+func (sub *subscription) Close() {
+	s := sub.source
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.pending)
+	}
+}
+
+// jobMatcher holds the filters of a query, with its kind and queue patterns
+// compiled once for all the jobs it is matched against
+type jobMatcher struct {
+	query        client.JobListQuery
+	kinds        []client.Pattern
+	excludeKinds []client.Pattern
+	queues       []client.Pattern
+}
+
+func newJobMatcher(query client.JobListQuery) *jobMatcher {
+	return &jobMatcher{
+		query:        query,
+		kinds:        client.CompilePatterns(query.Kinds),
+		excludeKinds: client.CompilePatterns(query.ExcludeKinds),
+		queues:       client.CompilePatterns(query.Queues),
+	}
+}
+
+// match reports whether the job passes the filters of the query
+func (m *jobMatcher) match(job *rivertype.JobRow) (bool, error) {
+	query := m.query
+	if len(query.States) > 0 && !slices.Contains(query.States, job.State) {
+		return false, nil
+	}
+	matchesKind := func(pattern client.Pattern) bool { return pattern.Match(job.Kind) }
+	if len(m.kinds) > 0 && !slices.ContainsFunc(m.kinds, matchesKind) {
+		return false, nil
+	}
+	if slices.ContainsFunc(m.excludeKinds, matchesKind) {
+		return false, nil
+	}
+	matchesQueue := func(pattern client.Pattern) bool { return pattern.Match(job.Queue) }
+	if len(m.queues) > 0 && !slices.ContainsFunc(m.queues, matchesQueue) {
+		return false, nil
+	}
+	hasTag := func(tag string) bool { return slices.Contains(job.Tags, tag) }
+	if len(query.Tags) > 0 && !slices.ContainsFunc(query.Tags, hasTag) {
+		return false, nil
+	}
+	for _, comparison := range query.Comparisons {
+		match, err := compareJob(job, comparison)
+		if err != nil || !match {
+			return false, err
+		}
+	}
+	for _, match := range query.JSONMatches {
+		found, err := matchJSON(job, match)
+		if err != nil || !found {
+			return false, err
+		}
+	}
+	for _, match := range query.TextMatches {
+		found, err := matchText(job, match)
+		if err != nil || !found {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// compareJob evaluates a comparison against the job. Like SQL, comparisons
+// with a missing time are false.
+func compareJob(job *rivertype.JobRow, comparison client.JobComparison) (bool, error) {
+	var order int
+	switch comparison.Field {
+	case "id", "attempt", "max_attempts", "priority":
+		value, ok := comparison.Value.(int64)
+		if !ok {
+			return false, fmt.Errorf("%s must be compared to an integer", comparison.Field)
+		}
+		field := map[string]int64{
+			"id":           job.ID,
+			"attempt":      int64(job.Attempt),
+			"max_attempts": int64(job.MaxAttempts),
+			"priority":     int64(job.Priority),
+		}[comparison.Field]
+		order = cmp.Compare(field, value)
+	case "created_at", "scheduled_at", "attempted_at", "finalized_at":
+		field := map[string]*time.Time{
+			"created_at":   &job.CreatedAt,
+			"scheduled_at": &job.ScheduledAt,
+			"attempted_at": job.AttemptedAt,
+			"finalized_at": job.FinalizedAt,
+		}[comparison.Field]
+		if field == nil {
+			return false, nil
+		}
+		var value time.Time
+		switch v := comparison.Value.(type) {
+		case time.Time:
+			value = v
+		case time.Duration:
+			value = time.Now().Add(-v)
+		default:
+			return false, fmt.Errorf("%s must be compared to a time or duration", comparison.Field)
+		}
+		order = field.Compare(value)
+	default:
+		return false, fmt.Errorf("cannot compare jobs on %q", comparison.Field)
+	}
+
+	switch comparison.Op {
+	case client.OpEqual:
+		return order == 0, nil
+	case client.OpNotEqual:
+		return order != 0, nil
+	case client.OpLess:
+		return order < 0, nil
+	case client.OpLessOrEqual:
+		return order <= 0, nil
+	case client.OpGreater:
+		return order > 0, nil
+	case client.OpGreaterOrEqual:
+		return order >= 0, nil
+	}
+	return false, fmt.Errorf("unknown comparison operator %q", comparison.Op)
+}
+
+// matchJSON reports whether the job's args or metadata hold one of the values at the path
+func matchJSON(job *rivertype.JobRow, match client.JSONMatch) (bool, error) {
+	var raw []byte
+	switch match.Column {
+	case "args":
+		raw = job.EncodedArgs
+	case "metadata":
+		raw = job.Metadata
+	default:
+		return false, fmt.Errorf("cannot match JSON in %q", match.Column)
+	}
+
+	var found any
+	if len(raw) == 0 || json.Unmarshal(raw, &found) != nil {
+		return false, nil
+	}
+	for _, key := range match.Path {
+		object, ok := found.(map[string]any)
+		if !ok {
+			return false, nil
+		}
+		if found, ok = object[key]; !ok {
+			return false, nil
+		}
+	}
+
+	for _, value := range match.Values {
+		// Round-trip through JSON so numbers compare as they are decoded
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return false, err
+		}
+		var want any
+		if err := json.Unmarshal(encoded, &want); err != nil {
+			return false, err
+		}
+		if reflect.DeepEqual(found, want) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchText reports whether any of the columns of the job contains the text
+func matchText(job *rivertype.JobRow, match client.TextMatch) (bool, error) {
+	for _, column := range match.Columns {
+		var values []string
+		switch column {
+		case "args", "metadata":
+			raw := job.EncodedArgs
+			if column == "metadata" {
+				raw = job.Metadata
+			}
+			if len(match.Path) == 0 {
+				values = []string{string(raw)}
+			} else if value, ok := jsonText(raw, match.Path); ok {
+				values = []string{value}
+			}
+		case "errors":
+			for _, attemptError := range job.Errors {
+				values = append(values, attemptError.Error)
+			}
+		default:
+			return false, fmt.Errorf("cannot search text in %q", column)
+		}
+		for _, value := range values {
+			if match.Contains(value) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// jsonText returns the value at the path as text, like Postgres' #>> operator
+func jsonText(raw []byte, path []string) (string, bool) {
+	for _, key := range path {
+		var object map[string]json.RawMessage
+		if json.Unmarshal(raw, &object) != nil {
+			return "", false
+		}
+		value, ok := object[key]
+		if !ok {
+			return "", false
+		}
+		raw = value
+	}
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return text, true
+	}
+	return string(raw), true
+}
+
+// jobBefore reports whether job a is listed before job b. Like the SQL
+// implementation, missing times sort last and the ID breaks ties.
+func jobBefore(a, b *rivertype.JobRow, field client.JobSortField, ascending bool) bool {
+	order := 0
+	switch field {
+	case client.JobSortCreatedAt:
+		order = a.CreatedAt.Compare(b.CreatedAt)
+	case client.JobSortScheduledAt:
+		order = a.ScheduledAt.Compare(b.ScheduledAt)
+	case client.JobSortAttemptedAt:
+		order = compareOptionalTimes(a.AttemptedAt, b.AttemptedAt, ascending)
+	case client.JobSortFinalizedAt:
+		order = compareOptionalTimes(a.FinalizedAt, b.FinalizedAt, ascending)
+	case client.JobSortKind:
+		order = strings.Compare(a.Kind, b.Kind)
+	case client.JobSortQueue:
+		order = strings.Compare(a.Queue, b.Queue)
+	case client.JobSortAttempt:
+		order = cmp.Compare(a.Attempt, b.Attempt)
+	}
+	if order == 0 {
+		order = cmp.Compare(a.ID, b.ID)
+	}
+	if ascending {
+		return order < 0
+	}
+	return order > 0
+}
+
+// compareOptionalTimes compares times so that nil comes last once the
+// direction is applied
+func compareOptionalTimes(a, b *time.Time, ascending bool) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil, b == nil:
+		last := 1
+		if !ascending {
+			last = -1
+		}
+		if a == nil {
+			return last
+		}
+		return -last
+	}
+	return a.Compare(*b)
+}
+
+// copyJob returns a copy of the job so callers can't modify the stored one
+func copyJob(job *rivertype.JobRow) *rivertype.JobRow {
+	copied := *job
+	copied.Errors = slices.Clone(job.Errors)
+	copied.Tags = slices.Clone(job.Tags)
+	return &copied
+}
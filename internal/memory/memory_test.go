@@ -0,0 +1,305 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+)
+
+func jobIDs(jobs []*rivertype.JobRow) []int64 {
+	ids := make([]int64, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.ID
+	}
+	return ids
+}
+
+func newTestSource() *Source {
+	source := NewSource()
+	source.AddJob(&rivertype.JobRow{Kind: "email.send", Queue: "mail", State: rivertype.JobStateCompleted, Tags: []string{"newsletter"}})
+	source.AddJob(&rivertype.JobRow{Kind: "email.bounce", Queue: "mail", State: rivertype.JobStateDiscarded, Attempt: 3})
+	source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateAvailable, EncodedArgs: []byte(`{"customer":"Acme Corp"}`)})
+	source.AddJob(&rivertype.JobRow{Kind: "report.send", State: rivertype.JobStateDiscarded, Attempt: 1})
+	return source
+}
+
+func TestJobListFilters(t *testing.T) {
+	source := newTestSource()
+
+	tests := []struct {
+		name  string
+		query client.JobListQuery
+		want  []int64
+	}{
+		{"all jobs newest first", client.JobListQuery{}, []int64{4, 3, 2, 1}},
+		{"kind", client.JobListQuery{Kinds: []string{"email.send", "report.send"}}, []int64{4, 1}},
+		{"kind wildcard", client.JobListQuery{Kinds: []string{"email.*"}}, []int64{2, 1}},
+		{"excluded kind", client.JobListQuery{ExcludeKinds: []string{"*.send"}}, []int64{3, 2}},
+		{"state", client.JobListQuery{States: []rivertype.JobState{rivertype.JobStateDiscarded}}, []int64{4, 2}},
+		{"queue", client.JobListQuery{Queues: []string{"default"}}, []int64{4, 3}},
+		{"tag", client.JobListQuery{Tags: []string{"newsletter"}}, []int64{1}},
+		{"comparison", client.JobListQuery{Comparisons: []client.JobComparison{{Field: "attempt", Op: ">=", Value: int64(1)}}}, []int64{4, 2}},
+		{"text", client.JobListQuery{TextMatches: []client.TextMatch{client.NewTextMatch([]string{"args"}, nil, "acme")}}, []int64{3}},
+		{"text at path", client.JobListQuery{TextMatches: []client.TextMatch{client.NewTextMatch([]string{"args"}, []string{"customer"}, "corp")}}, []int64{3}},
+		{"limit", client.JobListQuery{Limit: 2}, []int64{4, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, err := source.JobList(context.Background(), tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := jobIDs(jobs); !slices.Equal(got, tt.want) {
+				t.Errorf("got jobs %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobListPages(t *testing.T) {
+	source := newTestSource()
+
+	query := client.JobListQuery{Limit: 3}
+	var got []int64
+	for {
+		jobs, err := source.JobList(context.Background(), query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, jobIDs(jobs)...)
+		if len(jobs) < query.Limit {
+			break
+		}
+		query.After = jobs[len(jobs)-1]
+	}
+	if want := []int64{4, 3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("got jobs %v, want %v", got, want)
+	}
+}
+
+func TestJobListSortAndCursor(t *testing.T) {
+	source := newTestSource()
+
+	tests := []struct {
+		name      string
+		field     client.JobSortField
+		ascending bool
+		want      []int64
+	}{
+		{"id ascending", client.JobSortID, true, []int64{1, 2, 3, 4}},
+		{"kind ascending", client.JobSortKind, true, []int64{2, 1, 3, 4}},
+		{"queue descending with ID ties", client.JobSortQueue, false, []int64{2, 1, 4, 3}},
+		{"attempt descending", client.JobSortAttempt, false, []int64{2, 4, 3, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := client.JobListQuery{SortField: tt.field, Ascending: tt.ascending, Limit: 3}
+			var got []int64
+			for {
+				jobs, err := source.JobList(context.Background(), query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, jobIDs(jobs)...)
+				if len(jobs) < query.Limit {
+					break
+				}
+				query.After = jobs[len(jobs)-1]
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got jobs %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobListMissingTimesSortLast(t *testing.T) {
+	source := NewSource()
+	finalized := time.Now()
+	source.AddJob(&rivertype.JobRow{State: rivertype.JobStateCompleted, FinalizedAt: &finalized})
+	source.AddJob(&rivertype.JobRow{})
+
+	for _, ascending := range []bool{true, false} {
+		jobs, err := source.JobList(context.Background(), client.JobListQuery{SortField: client.JobSortFinalizedAt, Ascending: ascending})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := jobIDs(jobs); !slices.Equal(got, []int64{1, 2}) {
+			t.Errorf("ascending=%v: got jobs %v, want the unfinalized job last", ascending, got)
+		}
+	}
+}
+
+func TestJobMutations(t *testing.T) {
+	ctx := context.Background()
+	source := newTestSource()
+
+	retried, err := source.JobRetry(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retried.State != rivertype.JobStateAvailable {
+		t.Errorf("retried job is %s, want available", retried.State)
+	}
+
+	cancelled, err := source.JobCancel(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.State != rivertype.JobStateCancelled || cancelled.FinalizedAt == nil {
+		t.Errorf("cancelled job is %s, finalized at %v", cancelled.State, cancelled.FinalizedAt)
+	}
+
+	if _, err := source.JobDelete(ctx, 4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.JobGet(ctx, 4); !errors.Is(err, rivertype.ErrNotFound) {
+		t.Errorf("got %v for a deleted job, want ErrNotFound", err)
+	}
+}
+
+func TestJobBatchMutations(t *testing.T) {
+	ctx := context.Background()
+	source := newTestSource()
+	running := source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateRunning})
+
+	tests := []struct {
+		name  string
+		apply func() (int, error)
+		want  int
+	}{
+		{"cancel skips finalized and running jobs", func() (int, error) {
+			return source.JobCancelMany(ctx, []int64{1, 3, running.ID, 99})
+		}, 1},
+		{"retry skips running jobs", func() (int, error) {
+			return source.JobRetryMany(ctx, []int64{1, 2, running.ID, 99})
+		}, 2},
+		{"update skips jobs no longer pending", func() (int, error) {
+			return source.JobUpdateMany(ctx, []int64{1, 2, 3, running.ID}, client.JobUpdateParams{Priority: 2})
+		}, 2},
+		{"delete skips running jobs", func() (int, error) {
+			return source.JobDeleteMany(ctx, []int64{1, 4, running.ID, 99})
+		}, 2},
+	}
+	for _, tt := range tests {
+		count, err := tt.apply()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if count != tt.want {
+			t.Errorf("%s: changed %d jobs, want %d", tt.name, count, tt.want)
+		}
+	}
+
+	jobs, err := source.JobList(ctx, client.JobListQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := jobIDs(jobs), []int64{running.ID, 3, 2}; !slices.Equal(got, want) {
+		t.Errorf("got jobs %v after the batches, want %v", got, want)
+	}
+	if job, _ := source.JobGet(ctx, 2); job.Priority != 2 {
+		t.Errorf("updated job has priority %d, want 2", job.Priority)
+	}
+}
+
+func TestJobUpdate(t *testing.T) {
+	ctx := context.Background()
+	source := newTestSource()
+
+	updated, err := source.JobUpdate(ctx, 3, client.JobUpdateParams{Postpone: time.Hour, Priority: 2, Queue: "reports"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.State != rivertype.JobStateScheduled || !updated.ScheduledAt.After(time.Now().Add(50*time.Minute)) {
+		t.Errorf("postponed job is %s, scheduled at %v", updated.State, updated.ScheduledAt)
+	}
+	if updated.Priority != 2 || updated.Queue != "reports" {
+		t.Errorf("got priority %d in queue %q, want 2 in reports", updated.Priority, updated.Queue)
+	}
+	queues, err := source.QueueList(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.ContainsFunc(queues, func(queue *rivertype.Queue) bool { return queue.Name == "reports" }) {
+		t.Error("moving a job to a new queue did not add the queue")
+	}
+
+	updated, err = source.JobUpdate(ctx, 3, client.JobUpdateParams{ScheduledAt: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.State != rivertype.JobStateAvailable {
+		t.Errorf("job run now is %s, want available", updated.State)
+	}
+
+	if _, err := source.JobUpdate(ctx, 1, client.JobUpdateParams{Priority: 3}); !errors.Is(err, client.ErrJobNotPending) {
+		t.Errorf("got %v updating a completed job, want ErrJobNotPending", err)
+	}
+}
+
+func TestJobInsertUnique(t *testing.T) {
+	ctx := context.Background()
+	source := NewSource()
+	params := client.JobInsertParams{
+		Kind:   "report.build",
+		Args:   []byte(`{"customer": "Acme Corp"}`),
+		Unique: client.UniqueBy{Args: true},
+	}
+
+	first, err := source.JobInsert(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.UniqueSkippedAsDuplicate || first.Job.State != rivertype.JobStateAvailable || first.Job.Queue != "default" {
+		t.Errorf("got first insert %+v", first)
+	}
+
+	// Args match regardless of their formatting
+	params.Args = []byte(`{"customer":"Acme Corp"}`)
+	duplicate, err := source.JobInsert(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !duplicate.UniqueSkippedAsDuplicate || duplicate.Job.ID != first.Job.ID {
+		t.Errorf("got duplicate insert %+v, want job %d skipped", duplicate, first.Job.ID)
+	}
+
+	params.Args = []byte(`{"customer":"Globex"}`)
+	other, err := source.JobInsert(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.UniqueSkippedAsDuplicate {
+		t.Error("insert with other args was skipped")
+	}
+}
+
+func TestQueuePauseResume(t *testing.T) {
+	ctx := context.Background()
+	source := NewSource()
+	source.AddQueue("mail")
+
+	if err := source.QueuePause(ctx, "mail"); err != nil {
+		t.Fatal(err)
+	}
+	queues, _ := source.QueueList(ctx, 10)
+	if queues[0].PausedAt == nil {
+		t.Error("paused queue has no pause time")
+	}
+	if err := source.QueueResume(ctx, "mail"); err != nil {
+		t.Fatal(err)
+	}
+	queues, _ = source.QueueList(ctx, 10)
+	if queues[0].PausedAt != nil {
+		t.Error("resumed queue is still paused")
+	}
+	if err := source.QueuePause(ctx, "missing"); !errors.Is(err, rivertype.ErrNotFound) {
+		t.Errorf("got %v pausing a missing queue, want ErrNotFound", err)
+	}
+}
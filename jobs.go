@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/almottier/rivertui/monitor"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listStates    []string
+	listKinds     []string
+	listQueues    []string
+	listLimit     int
+	listAfter     string
+	listSort      string
+	listAscending bool
+	listOutput    string
+
+	getOutput string
+	dryRun    bool
+
+	jobsCmd = &cobra.Command{
+		Use:   "jobs",
+		Short: "Inspect and manage River jobs without the terminal UI",
+	}
+
+	jobsListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List jobs matching the given filters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := parseOutputFormat(listOutput)
+			if err != nil {
+				return err
+			}
+
+			states, err := monitor.ParseJobStates(listStates)
+			if err != nil {
+				return err
+			}
+
+			if err := connect(cmd); err != nil {
+				return err
+			}
+
+			filter := monitor.NewJobFilter()
+			filter.SetStates(states)
+			filter.SetKindFilter(listKinds)
+			filter.SetQueueFilter(listQueues)
+
+			var after *client.JobListCursor
+			if listAfter != "" {
+				after, err = parseCursor(cmd, listAfter)
+				if err != nil {
+					return err
+				}
+				// A cursor continues the listing in the order it was made for
+				listSort, listAscending = string(after.SortField), after.Ascending
+			}
+			sort, err := monitor.ParseJobSort(listSort, listAscending)
+			if err != nil {
+				return err
+			}
+
+			pageSize := 500
+			if listLimit > 0 && listLimit < pageSize {
+				pageSize = listLimit
+			}
+			pagination := monitor.NewPagination(pageSize)
+			if after != nil {
+				pagination.StartAfter(after.Job)
+			}
+
+			writer := newJobWriter(cmd.OutOrStdout(), format)
+			count := 0
+			var last *rivertype.JobRow
+			more := false
+			for {
+				query := filter.Query(pagination.PageSize())
+				sort.Apply(&query)
+				query.After = pagination.GetCurrentCursor()
+
+				jobs, err := appClient.JobList(cmd.Context(), query)
+				if err != nil {
+					return fmt.Errorf("failed to list jobs: %w", err)
+				}
+				pagination.Update(jobs)
+
+				for _, job := range jobs {
+					if listLimit > 0 && count >= listLimit {
+						more = true
+						break
+					}
+					if err := writer.Write(job); err != nil {
+						return fmt.Errorf("failed to write job %d: %w", job.ID, err)
+					}
+					last = job
+					count++
+				}
+
+				if listLimit > 0 && count >= listLimit {
+					more = more || pagination.HasNextPage()
+					break
+				}
+				if !pagination.NextPage() {
+					break
+				}
+			}
+
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			// Tell scripts how to fetch the rest when --limit cut the listing short
+			if more && last != nil {
+				next, err := client.JobListCursorFromJob(last, client.JobSortField(listSort), listAscending).MarshalText()
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "more jobs: continue with --after %s\n", next)
+			}
+			return nil
+		},
+	}
+)
+
+var (
+	jobsGetCmd = &cobra.Command{
+		Use:          "get [job-id...]",
+		Short:        "Show jobs by ID (reads IDs from stdin when none are given)",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := parseOutputFormat(getOutput)
+			if err != nil {
+				return err
+			}
+
+			ids, err := parseJobIDs(args, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			if err := connect(cmd); err != nil {
+				return err
+			}
+
+			writer := newJobWriter(cmd.OutOrStdout(), format)
+			failed := 0
+			for _, id := range ids {
+				job, err := appClient.JobGet(cmd.Context(), id)
+				if err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "job %d: %v\n", id, err)
+					failed++
+					continue
+				}
+				if err := writer.Write(job); err != nil {
+					return fmt.Errorf("failed to write job %d: %w", id, err)
+				}
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d jobs could not be fetched", failed, len(ids))
+			}
+			return nil
+		},
+	}
+
+	jobsRetryCmd = newJobActionCmd("retry", "Retry jobs by ID", "retried",
+		func(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+			return appClient.JobRetry(ctx, id)
+		})
+
+	jobsCancelCmd = newJobActionCmd("cancel", "Cancel jobs by ID", "cancelled",
+		func(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+			return appClient.JobCancel(ctx, id)
+		})
+
+	jobsDeleteCmd = newJobActionCmd("delete", "Delete jobs by ID (running jobs are refused)", "deleted",
+		func(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+			return appClient.JobDelete(ctx, id)
+		})
+)
+
+// newJobActionCmd builds a subcommand applying a mutation to each given job ID
+func newJobActionCmd(name, short, pastTense string, action func(ctx context.Context, id int64) (*rivertype.JobRow, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          name + " [job-id...]",
+		Short:        short + " (reads IDs from stdin when none are given)",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := parseJobIDs(args, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			if err := connect(cmd); err != nil {
+				return err
+			}
+			if appConfig.ReadOnly && !dryRun {
+				return fmt.Errorf("read-only mode is enabled, refusing to %s jobs", name)
+			}
+
+			out := cmd.OutOrStdout()
+			failed := 0
+			for _, id := range ids {
+				if dryRun {
+					job, err := appClient.JobGet(cmd.Context(), id)
+					if err != nil {
+						fmt.Fprintf(out, "%d\terror\t%v\n", id, err)
+						failed++
+						continue
+					}
+					fmt.Fprintf(out, "%d\tdry-run\twould be %s (kind=%s state=%s)\n", id, pastTense, job.Kind, job.State)
+					continue
+				}
+
+				job, err := action(cmd.Context(), id)
+				if err != nil {
+					fmt.Fprintf(out, "%d\terror\t%v\n", id, err)
+					failed++
+					continue
+				}
+				fmt.Fprintf(out, "%d\t%s\tstate=%s\n", id, pastTense, job.State)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d jobs failed", failed, len(ids))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only check that the jobs exist and print what would be done")
+	return cmd
+}
+
+// parseJobIDs parses job IDs from the arguments, or from whitespace-separated input when there are none
+func parseJobIDs(args []string, stdin io.Reader) ([]int64, error) {
+	if len(args) == 0 || (len(args) == 1 && args[0] == "-") {
+		args = nil
+		scanner := bufio.NewScanner(stdin)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			args = append(args, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read job IDs from stdin: %w", err)
+		}
+	}
+
+	ids := make([]int64, 0, len(args))
+	for _, arg := range args {
+		for _, field := range strings.Split(arg, ",") {
+			if field == "" {
+				continue
+			}
+			id, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid job ID %q", field)
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no job IDs given")
+	}
+	return ids, nil
+}
+
+// parseCursor accepts either a job ID, listed after in the order of --sort,
+// or a cursor printed by a previous listing
+func parseCursor(cmd *cobra.Command, value string) (*client.JobListCursor, error) {
+	if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+		job, err := appClient.JobGet(cmd.Context(), id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get job %d for --after: %w", id, err)
+		}
+		return client.JobListCursorFromJob(job, client.JobSortField(listSort), listAscending), nil
+	}
+
+	var cursor client.JobListCursor
+	if err := cursor.UnmarshalText([]byte(value)); err != nil {
+		return nil, fmt.Errorf("invalid --after value %q: expected a job ID or cursor: %w", value, err)
+	}
+	if (cmd.Flags().Changed("sort") && string(cursor.SortField) != listSort) ||
+		(cmd.Flags().Changed("ascending") && cursor.Ascending != listAscending) {
+		return nil, fmt.Errorf("the --after cursor continues a listing sorted by %s, which --sort or --ascending contradicts", cursor.SortField)
+	}
+	return &cursor, nil
+}
+
+func init() {
+	jobsListCmd.Flags().StringSliceVar(&listStates, "state", nil, "Job states to include, comma-separated (e.g. retryable,discarded)")
+	jobsListCmd.Flags().StringSliceVar(&listKinds, "kind", nil, "Job kinds to include, comma-separated; prefix a kind with - to exclude it")
+	jobsListCmd.Flags().StringSliceVar(&listQueues, "queue", nil, "Queues to include, comma-separated")
+	jobsListCmd.Flags().IntVar(&listLimit, "limit", 100, "Maximum number of jobs to print (0 for no limit)")
+	jobsListCmd.Flags().StringVar(&listAfter, "after", "", "Only list jobs after this job ID or cursor")
+	jobsListCmd.Flags().StringVar(&listSort, "sort", "id", "Sort by id, created_at, scheduled_at, attempted_at, finalized_at, kind, queue or attempt")
+	jobsListCmd.Flags().BoolVar(&listAscending, "ascending", false, "Sort in ascending order instead of descending")
+	jobsListCmd.Flags().StringVarP(&listOutput, "output", "o", "table", "Output format: table, json, csv or ndjson")
+
+	jobsGetCmd.Flags().StringVarP(&getOutput, "output", "o", "json", "Output format: table, json, csv or ndjson")
+
+	jobsCmd.AddCommand(jobsListCmd, jobsGetCmd, jobsRetryCmd, jobsCancelCmd, jobsDeleteCmd)
+	rootCmd.AddCommand(jobsCmd)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	insertKind        string
+	insertArgs        string
+	insertQueue       string
+	insertPriority    int
+	insertMaxAttempts int
+	insertTags        []string
+	insertScheduleAt  string
+	insertUniqueBy    []string
+	insertFile        string
+	insertOutput      string
+
+	jobsInsertCmd = &cobra.Command{
+		Use:   "insert",
+		Short: "Insert jobs of any kind, from flags or an NDJSON file",
+		Long: `Insert jobs of any kind, from flags or an NDJSON file.
+
+Jobs go through River's insert path with their args as raw JSON, so no
+worker needs to be registered for their kind. With --file, each line is a
+JSON object with the fields kind, args, queue, priority, max_attempts,
+tags, metadata, scheduled_at and unique_by, and the flags give the
+defaults for the fields a line leaves out.`,
+		Example: `  rivertui jobs insert --kind email --args '{"to":"a@example.com"}' --queue mailers
+  rivertui jobs insert --kind report --schedule-at 2h --unique-by args,period=24h
+  rivertui jobs insert --file jobs.ndjson --queue backfill`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := parseOutputFormat(insertOutput)
+			if err != nil {
+				return err
+			}
+
+			defaults, err := insertDefaults(time.Now())
+			if err != nil {
+				return err
+			}
+
+			var batch []client.JobInsertParams
+			if insertFile != "" {
+				batch, err = readInsertFile(cmd, defaults)
+				if err != nil {
+					return err
+				}
+			} else {
+				if err := defaults.Validate(); err != nil {
+					return err
+				}
+				batch = []client.JobInsertParams{defaults}
+			}
+			if len(batch) == 0 {
+				return fmt.Errorf("no jobs to insert")
+			}
+
+			if err := connect(cmd); err != nil {
+				return err
+			}
+			if appConfig.ReadOnly {
+				return fmt.Errorf("read-only mode is enabled, refusing to insert jobs")
+			}
+
+			writer := newJobWriter(cmd.OutOrStdout(), format)
+			failed := 0
+			for i, params := range batch {
+				result, err := appClient.JobInsert(cmd.Context(), params)
+				if err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "job %d (%s): %v\n", i+1, params.Kind, err)
+					failed++
+					continue
+				}
+				if result.UniqueSkippedAsDuplicate {
+					fmt.Fprintf(cmd.ErrOrStderr(), "job %d (%s): skipped as a duplicate of job %d\n", i+1, params.Kind, result.Job.ID)
+					continue
+				}
+				if err := writer.Write(result.Job); err != nil {
+					return fmt.Errorf("failed to write job %d: %w", result.Job.ID, err)
+				}
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d jobs could not be inserted", failed, len(batch))
+			}
+			return nil
+		},
+	}
+)
+
+// insertLine is a job to insert in an NDJSON file
+type insertLine struct {
+	Kind        string          `json:"kind"`
+	Args        json.RawMessage `json:"args"`
+	Queue       string          `json:"queue"`
+	Priority    int             `json:"priority"`
+	MaxAttempts int             `json:"max_attempts"`
+	Tags        []string        `json:"tags"`
+	Metadata    json.RawMessage `json:"metadata"`
+	// ScheduledAt takes the same values as --schedule-at
+	ScheduledAt string   `json:"scheduled_at"`
+	UniqueBy    []string `json:"unique_by"`
+}
+
+// insertDefaults builds the job described by the flags, which is also the
+// default for each line of a file
+func insertDefaults(now time.Time) (client.JobInsertParams, error) {
+	scheduledAt, err := client.ParseScheduledAt(insertScheduleAt, now)
+	if err != nil {
+		return client.JobInsertParams{}, err
+	}
+	unique, err := client.ParseUniqueBy(insertUniqueBy)
+	if err != nil {
+		return client.JobInsertParams{}, err
+	}
+	return client.JobInsertParams{
+		Kind:        insertKind,
+		Args:        json.RawMessage(insertArgs),
+		Queue:       insertQueue,
+		Priority:    insertPriority,
+		MaxAttempts: insertMaxAttempts,
+		Tags:        insertTags,
+		ScheduledAt: scheduledAt,
+		Unique:      unique,
+	}, nil
+}
+
+// readInsertFile reads the jobs of --file, or of stdin for -, and stops at
+// the first invalid line so that nothing is inserted from a broken file
+func readInsertFile(cmd *cobra.Command, defaults client.JobInsertParams) ([]client.JobInsertParams, error) {
+	var in io.Reader = cmd.InOrStdin()
+	if insertFile != "-" {
+		file, err := os.Open(insertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", insertFile, err)
+		}
+		defer file.Close()
+		in = file
+	}
+
+	var batch []client.JobInsertParams
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	now := time.Now()
+	for number := 1; scanner.Scan(); number++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		params, err := parseInsertLine(line, defaults, now)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", insertFile, number, err)
+		}
+		batch = append(batch, params)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", insertFile, err)
+	}
+	return batch, nil
+}
+
+// parseInsertLine parses a line of an NDJSON file, filling in the fields it leaves out from defaults
+func parseInsertLine(line []byte, defaults client.JobInsertParams, now time.Time) (client.JobInsertParams, error) {
+	var job insertLine
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&job); err != nil {
+		return client.JobInsertParams{}, fmt.Errorf("invalid JSON: %v", err)
+	}
+
+	params := defaults
+	if job.Kind != "" {
+		params.Kind = job.Kind
+	}
+	if len(job.Args) > 0 {
+		params.Args = job.Args
+	}
+	if job.Queue != "" {
+		params.Queue = job.Queue
+	}
+	if job.Priority != 0 {
+		params.Priority = job.Priority
+	}
+	if job.MaxAttempts != 0 {
+		params.MaxAttempts = job.MaxAttempts
+	}
+	if job.Tags != nil {
+		params.Tags = job.Tags
+	}
+	if len(job.Metadata) > 0 {
+		params.Metadata = job.Metadata
+	}
+	if job.ScheduledAt != "" {
+		scheduledAt, err := client.ParseScheduledAt(job.ScheduledAt, now)
+		if err != nil {
+			return client.JobInsertParams{}, err
+		}
+		params.ScheduledAt = scheduledAt
+	}
+	if job.UniqueBy != nil {
+		unique, err := client.ParseUniqueBy(job.UniqueBy)
+		if err != nil {
+			return client.JobInsertParams{}, err
+		}
+		params.Unique = unique
+	}
+
+	if err := params.Validate(); err != nil {
+		return client.JobInsertParams{}, err
+	}
+	return params, nil
+}
+
+func init() {
+	jobsInsertCmd.Flags().StringVar(&insertKind, "kind", "", "Kind of the job (required unless every line of --file has one)")
+	jobsInsertCmd.Flags().StringVar(&insertArgs, "args", "{}", "Args of the job as a JSON object")
+	jobsInsertCmd.Flags().StringVar(&insertQueue, "queue", "", "Queue to insert the job in (default \"default\")")
+	jobsInsertCmd.Flags().IntVar(&insertPriority, "priority", 0, "Priority of the job, from 1 (highest) to 4 (default 1)")
+	jobsInsertCmd.Flags().IntVar(&insertMaxAttempts, "max-attempts", 0, "Maximum number of attempts (default 25)")
+	jobsInsertCmd.Flags().StringSliceVar(&insertTags, "tags", nil, "Tags of the job, comma-separated")
+	jobsInsertCmd.Flags().StringVar(&insertScheduleAt, "schedule-at", "", "When to run the job: a duration from now (30m, 2h), a date or an RFC 3339 time")
+	jobsInsertCmd.Flags().StringSliceVar(&insertUniqueBy, "unique-by", nil, "Skip the insert when a matching job exists, by any of args, queue, period=DURATION and state=STATE")
+	jobsInsertCmd.Flags().StringVarP(&insertFile, "file", "f", "", "NDJSON file of jobs to insert, one per line (- for stdin)")
+	jobsInsertCmd.Flags().StringVarP(&insertOutput, "output", "o", "table", "Output format: table, json, csv or ndjson")
+
+	jobsCmd.AddCommand(jobsInsertCmd)
+}
@@ -12,16 +12,24 @@ import (
 )
 
 var (
+	configPath      string
+	profileName     string
 	dbURL           string
 	refreshInterval time.Duration
+	readOnly        bool
 	jobID           int64
-	kindFilter      string
+	stateFilter     []string
+	kindFilter      []string
+	queueFilter     []string
+	startDashboard  bool
 	appConfig       *config.Config
 	appClient       *client.Client
 
 	rootCmd = &cobra.Command{
 		Use:   "rivertui",
 		Short: "rivertui is a terminal-based user interface for River Queue",
+		// Errors are printed by main
+		SilenceErrors: true,
 		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
 			if appClient != nil {
 				appClient.Close()
@@ -29,28 +37,25 @@ var (
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-
-			var err error
-			appConfig, err = config.LoadConfig()
+			states, err := monitor.ParseJobStates(stateFilter)
 			if err != nil {
-				return fmt.Errorf("failed to load configuration: %w", err)
+				return err
 			}
 
-			config.UpdateConfigFromFlags(appConfig, dbURL, refreshInterval)
-
-			if appConfig.Database.URL == "" {
-				return fmt.Errorf("database URL is required. Set it via --database-url flag or RIVER_DATABASE_URL environment variable")
+			if err := connect(cmd); err != nil {
+				return err
 			}
 
-			appClient, err = client.New(cmd.Context(), appConfig)
-			if err != nil {
-				return fmt.Errorf("failed to initialize client: %w", err)
+			monitor := monitor.NewMonitorApp(appClient, appConfig, jobID, states, kindFilter, queueFilter)
+			if startDashboard {
+				monitor.ShowDashboard()
 			}
 
-			monitor := monitor.NewMonitorApp(appClient, appConfig, jobID, kindFilter)
-			monitor.StartRefreshLoop()
-
-			if err := monitor.Run(); err != nil {
+			err = monitor.Run()
+			// The monitor may have switched profiles, so close whichever client is active
+			monitor.Client().Close()
+			appClient = nil
+			if err != nil {
 				return fmt.Errorf("failed to run monitor: %w", err)
 			}
 
@@ -59,11 +64,58 @@ var (
 	}
 )
 
+// loadConfig resolves the configuration from the config file, environment and flags
+func loadConfig(cmd *cobra.Command) error {
+	var err error
+	appConfig, err = config.LoadConfig(configPath, profileName)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Only an explicitly set refresh flag overrides the environment and config file
+	refresh := time.Duration(0)
+	if cmd.Flags().Changed("refresh") {
+		refresh = refreshInterval
+	}
+	config.UpdateConfigFromFlags(appConfig, dbURL, refresh, readOnly)
+
+	if _, err := monitor.ParseJobStates(appConfig.Filters.States); err != nil {
+		return fmt.Errorf("invalid filters in profile %q: %w", appConfig.Profile, err)
+	}
+
+	return nil
+}
+
+// connect loads the configuration and initializes the shared client
+func connect(cmd *cobra.Command) error {
+	if err := loadConfig(cmd); err != nil {
+		return err
+	}
+
+	if appConfig.Database.URL == "" {
+		return fmt.Errorf("database URL is required. Set it via --database-url flag, RIVER_DATABASE_URL environment variable or a config file profile")
+	}
+
+	var err error
+	appClient, err = client.New(cmd.Context(), appConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+
+	return nil
+}
+
 func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Path to the config file (env: RIVER_CONFIG, default: $XDG_CONFIG_HOME/rivertui/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "Config file profile to use (env: RIVER_PROFILE)")
 	rootCmd.PersistentFlags().StringVar(&dbURL, "database-url", "", "PostgreSQL connection string/URL (env: RIVER_DATABASE_URL)")
 	rootCmd.PersistentFlags().DurationVar(&refreshInterval, "refresh", 1*time.Second, "Refresh interval for the monitor")
-	rootCmd.PersistentFlags().Int64Var(&jobID, "job-id", 0, "Job ID to view details for (starts in details view if provided)")
-	rootCmd.PersistentFlags().StringVar(&kindFilter, "kind", "", "Job kind to filter by (starts with kind filter applied if provided)")
+	rootCmd.PersistentFlags().BoolVar(&readOnly, "read-only", false, "Disable all operations that modify jobs or queues (env: RIVER_READ_ONLY)")
+	rootCmd.Flags().Int64Var(&jobID, "job-id", 0, "Job ID to view details for (starts in details view if provided)")
+	rootCmd.Flags().BoolVar(&startDashboard, "dashboard", false, "Start on the dashboard instead of the job list")
+	rootCmd.Flags().StringSliceVar(&stateFilter, "state", nil, "Job states to filter by, comma-separated (e.g. retryable,discarded)")
+	rootCmd.Flags().StringSliceVar(&kindFilter, "kind", nil, "Job kinds to filter by, comma-separated or repeated; prefix a kind with - to exclude it")
+	rootCmd.Flags().StringSliceVar(&queueFilter, "queue", nil, "Queues to filter by, comma-separated or repeated")
 }
 
 func main() {
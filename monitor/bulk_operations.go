@@ -0,0 +1,291 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+)
+
+const (
+	bulkListPageSize = 1000
+	bulkBatchSize    = 100
+)
+
+// jobAction describes a mutation that can be applied to a set of jobs. Actions
+// bind the client they were created with, so a batch keeps using the
+// connection it was started on even if the active connection changes.
+type jobAction struct {
+	title      string // e.g. "Retry"
+	verb       string // e.g. "retry"
+	inProgress string // e.g. "Retrying"
+	pastTense  string // e.g. "retried"
+	// skipped says why jobs of a batch may be left unchanged
+	skipped string
+	// destructive actions are confirmed by typing the number of jobs
+	destructive bool
+	// apply changes a batch of jobs and returns how many it changed
+	apply func(ctx context.Context, ids []int64) (int, error)
+}
+
+func (m *MonitorApp) retryAction() jobAction {
+	cli := m.client
+	return jobAction{
+		title:      "Retry",
+		verb:       "retry",
+		inProgress: "Retrying",
+		pastTense:  "retried",
+		skipped:    "running or gone",
+		apply: func(ctx context.Context, ids []int64) (int, error) {
+			return cli.JobRetryMany(ctx, ids)
+		},
+	}
+}
+
+func (m *MonitorApp) cancelAction() jobAction {
+	cli := m.client
+	return jobAction{
+		title:      "Cancel",
+		verb:       "cancel",
+		inProgress: "Cancelling",
+		pastTense:  "cancelled",
+		skipped:    "already finalized or gone",
+		apply: func(ctx context.Context, ids []int64) (int, error) {
+			return cli.JobCancelMany(ctx, ids)
+		},
+	}
+}
+
+// updateAction applies the same changes to each marked job
+func (m *MonitorApp) updateAction(params client.JobUpdateParams) jobAction {
+	cli := m.client
+	return jobAction{
+		title:      "Update",
+		verb:       "update",
+		inProgress: "Updating",
+		pastTense:  "updated",
+		skipped:    "no longer pending or gone",
+		apply: func(ctx context.Context, ids []int64) (int, error) {
+			return cli.JobUpdateMany(ctx, ids, params)
+		},
+	}
+}
+
+func (m *MonitorApp) deleteAction() jobAction {
+	cli := m.client
+	return jobAction{
+		title:       "Delete",
+		verb:        "delete",
+		inProgress:  "Deleting",
+		pastTense:   "deleted",
+		skipped:     "running or gone",
+		destructive: true,
+		apply: func(ctx context.Context, ids []int64) (int, error) {
+			return cli.JobDeleteMany(ctx, ids)
+		},
+	}
+}
+
+// handleBulkAction counts every job matching the active filter and asks for
+// confirmation. Counting can be aborted like the batch itself.
+func (m *MonitorApp) handleBulkAction(action jobAction) {
+	if !m.allowMutation() {
+		return
+	}
+	if m.batchCancel != nil {
+		m.ui.statusBar.SetText("[yellow]A bulk operation is already running[white]")
+		return
+	}
+
+	// Snapshot the filter on the UI goroutine so later filter changes don't affect the operation
+	query := m.filter.Query(bulkListPageSize)
+	description := m.filter.Describe()
+	cli := m.client
+	timeout := m.fetcher.timeout
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.batchCancel = cancel
+	m.showCountProgress(action, 0)
+
+	go func() {
+		ids, err := collectJobIDs(ctx, cli, query, timeout, func(counted int) {
+			m.ui.app.QueueUpdateDraw(func() {
+				if m.batchCancel != nil {
+					m.showCountProgress(action, counted)
+				}
+			})
+		})
+		aborted := ctx.Err() != nil
+		cancel()
+		m.ui.app.QueueUpdateDraw(func() {
+			m.batchCancel = nil
+			m.closeProgress()
+			switch {
+			case aborted:
+				m.ui.statusBar.SetText(fmt.Sprintf("[yellow]%s aborted while counting jobs[white]", action.title))
+			case err != nil:
+				m.ui.statusBar.SetText(fmt.Sprintf("[red]Error counting jobs: %v[white]", err))
+			case len(ids) == 0:
+				m.ui.statusBar.SetText(fmt.Sprintf("[yellow]No jobs match %s[white]", description))
+			default:
+				m.setListModeStatus()
+				m.confirmBatch(fmt.Sprintf("%s All Matching Jobs", action.title), "jobs matching "+description, action, ids,
+					func() { m.runBatch(action, ids) })
+			}
+		})
+	}()
+}
+
+// confirmBatch asks to apply the action to the jobs, having their number
+// typed when the action is destructive
+func (m *MonitorApp) confirmBatch(title, description string, action jobAction, ids []int64, onConfirm func()) {
+	if action.destructive {
+		m.confirmBatchDelete(description, ids, onConfirm)
+		return
+	}
+	m.showConfirmationModal(
+		title,
+		fmt.Sprintf("Are you sure you want to %s [#EF4444]%d[white] %s?\n\n[#60A5FA]Y[white]: Yes, %s them all\n[#60A5FA]N[white]: No, go back", action.verb, len(ids), description, action.verb),
+		onConfirm,
+		func() {},
+	)
+}
+
+// collectJobIDs pages through every job matching the query and returns their
+// IDs, giving each page the timeout and reporting the running count
+func collectJobIDs(ctx context.Context, cli DataSource, query client.JobListQuery, timeout time.Duration, progress func(counted int)) ([]int64, error) {
+	var ids []int64
+	pagination := NewPagination(query.Limit)
+	for {
+		query.After = pagination.GetCurrentCursor()
+
+		pageCtx, cancel := context.WithTimeout(ctx, timeout)
+		jobs, err := cli.JobList(pageCtx, query)
+		timedOut := pageCtx.Err() == context.DeadlineExceeded
+		cancel()
+		if err != nil {
+			if timedOut {
+				return nil, fmt.Errorf("listing a page of jobs timed out after %s", timeout)
+			}
+			return nil, fmt.Errorf("failed to list jobs: %w", err)
+		}
+		pagination.Update(jobs)
+
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+		progress(len(ids))
+
+		if !pagination.NextPage() {
+			return ids, nil
+		}
+	}
+}
+
+// runBatch applies the action to all IDs in batches, reporting progress until done or aborted
+func (m *MonitorApp) runBatch(action jobAction, ids []int64) {
+	if !m.allowMutation() {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.batchCancel = cancel
+	m.showProgress(action, 0, len(ids), 0)
+
+	go func() {
+		done, failed, skipped := 0, 0, 0
+		var lastErr error
+		for start := 0; start < len(ids) && ctx.Err() == nil; start += bulkBatchSize {
+			batch := ids[start:min(start+bulkBatchSize, len(ids))]
+			changed, err := action.apply(ctx, batch)
+			if ctx.Err() != nil {
+				break
+			}
+			if err != nil {
+				failed += len(batch)
+				lastErr = err
+			} else {
+				skipped += len(batch) - changed
+			}
+			done += len(batch)
+
+			processed, failures := done, failed
+			m.ui.app.QueueUpdateDraw(func() {
+				m.showProgress(action, processed, len(ids), failures)
+			})
+		}
+
+		aborted := ctx.Err() != nil
+		cancel()
+		m.ui.app.QueueUpdateDraw(func() {
+			m.batchCancel = nil
+			m.closeProgress()
+
+			var summary strings.Builder
+			if aborted {
+				summary.WriteString(fmt.Sprintf("[yellow]%s aborted after %d of %d jobs[white]", action.title, done, len(ids)))
+			} else {
+				summary.WriteString(fmt.Sprintf("[green]%d jobs %s[white]", done-failed-skipped, action.pastTense))
+			}
+			if skipped > 0 {
+				summary.WriteString(fmt.Sprintf(" | [yellow]%d skipped, %s[white]", skipped, action.skipped))
+			}
+			if failed > 0 {
+				summary.WriteString(fmt.Sprintf(" | [red]%d failed, last error: %v[white]", failed, lastErr))
+			}
+			m.ui.statusBar.SetText(summary.String())
+		})
+	}()
+}
+
+// showProgress displays or updates the progress modal for a running batch
+func (m *MonitorApp) showProgress(action jobAction, done, total, failed int) {
+	const barWidth = 40
+	filled := 0
+	if total > 0 {
+		filled = done * barWidth / total
+	}
+
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("%s jobs: %d / %d", action.inProgress, done, total))
+	if failed > 0 {
+		text.WriteString(fmt.Sprintf(" ([#EF4444]%d failed[white])", failed))
+	}
+	text.WriteString("\n\n[#3B82F6]" + strings.Repeat("█", filled) + "[#64748B]" + strings.Repeat("░", barWidth-filled) + "[white]\n\n")
+	text.WriteString("[#60A5FA]Esc[white]: Abort")
+	m.showProgressModal(action.title, text.String())
+}
+
+// showCountProgress displays or updates the progress modal while counting the jobs of a bulk action
+func (m *MonitorApp) showCountProgress(action jobAction, counted int) {
+	m.showProgressModal(action.title,
+		fmt.Sprintf("Counting matching jobs: %d so far\n\n\n\n[#60A5FA]Esc[white]: Abort", counted))
+}
+
+// showProgressModal shows the progress modal with the given text
+func (m *MonitorApp) showProgressModal(title, text string) {
+	m.ui.progressModal.SetTitle(fmt.Sprintf(" ⏳ %s ", title))
+	m.ui.progressModal.SetText(text)
+
+	if front, _ := m.ui.pages.GetFrontPage(); front != PageProgress {
+		m.ui.pages.ShowPage(PageProgress)
+		m.ui.app.SetFocus(m.ui.progressModal)
+	}
+}
+
+// closeProgress hides the progress modal and returns to the job list
+func (m *MonitorApp) closeProgress() {
+	m.ui.pages.HidePage(PageProgress)
+	m.ui.pages.SwitchToPage(PageList)
+	m.ui.app.SetFocus(m.ui.jobList)
+	m.loadJobList()
+}
+
+// abortBatch requests cancellation of the running batch
+func (m *MonitorApp) abortBatch() {
+	if m.batchCancel != nil {
+		m.batchCancel()
+		m.ui.progressModal.SetTitle(" ⏳ Aborting... ")
+	}
+}
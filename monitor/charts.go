@@ -0,0 +1,271 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	chartPoints          = 60
+	chartMaxKinds        = 15
+	chartRefreshInterval = 10 * time.Second
+)
+
+// sparkBlocks are the sparkline levels from lowest to highest
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// chartWindow is a selectable time range, split into chartPoints buckets
+type chartWindow struct {
+	label  string
+	window time.Duration
+}
+
+var chartWindows = []chartWindow{
+	{label: "15m", window: 15 * time.Minute},
+	{label: "1h", window: time.Hour},
+	{label: "24h", window: 24 * time.Hour},
+}
+
+func (w chartWindow) bucket() time.Duration {
+	return w.window / chartPoints
+}
+
+// chartMetric is a series shown in the chart view
+type chartMetric struct {
+	name  string
+	label string
+	color *tcell.Color
+}
+
+var chartMetrics = []chartMetric{
+	{name: client.MetricEnqueued, label: "Enqueued", color: &ColorPrimary},
+	{name: client.MetricCompleted, label: "Completed", color: &ColorSuccess},
+	{name: client.MetricErrored, label: "Errored", color: &ColorWarning},
+	{name: client.MetricDiscarded, label: "Discarded", color: &ColorError},
+}
+
+// ChartState holds the chart view selection and the last fetched series
+type ChartState struct {
+	windowIndex int
+	metricIndex int
+	points      []client.SeriesPoint
+	fetchedAt   time.Time
+}
+
+func newChartState() *ChartState {
+	return &ChartState{}
+}
+
+// Window returns the selected time range
+func (cs *ChartState) Window() chartWindow {
+	return chartWindows[cs.windowIndex]
+}
+
+// Metric returns the metric used for the per-kind breakdown
+func (cs *ChartState) Metric() chartMetric {
+	return chartMetrics[cs.metricIndex]
+}
+
+// NextWindow selects the next time range and invalidates the fetched series
+func (cs *ChartState) NextWindow() {
+	cs.windowIndex = (cs.windowIndex + 1) % len(chartWindows)
+	cs.fetchedAt = time.Time{}
+}
+
+// NextMetric selects the next metric for the per-kind breakdown
+func (cs *ChartState) NextMetric() {
+	cs.metricIndex = (cs.metricIndex + 1) % len(chartMetrics)
+}
+
+// Stale reports whether the series should be fetched again
+func (cs *ChartState) Stale() bool {
+	return time.Since(cs.fetchedAt) >= chartRefreshInterval
+}
+
+// series is a metric's event counts per bucket
+type series struct {
+	name   string
+	counts []int64
+	total  int64
+}
+
+// buildSeries sums points into one series per key, dropping points outside the window
+func buildSeries(points []client.SeriesPoint, key func(client.SeriesPoint) (string, bool)) map[string]*series {
+	result := make(map[string]*series)
+	for _, point := range points {
+		name, ok := key(point)
+		if !ok || point.Bucket < 0 {
+			continue
+		}
+		s, exists := result[name]
+		if !exists {
+			s = &series{name: name, counts: make([]int64, chartPoints)}
+			result[name] = s
+		}
+		// The bucket of an event at exactly now falls one past the end
+		bucket := min(point.Bucket, chartPoints-1)
+		s.counts[bucket] += point.Count
+		s.total += point.Count
+	}
+	return result
+}
+
+// sparkline renders counts as a line of block characters scaled to the largest count
+func sparkline(counts []int64, color tcell.Color) string {
+	var peak int64
+	for _, count := range counts {
+		peak = max(peak, count)
+	}
+
+	var line strings.Builder
+	line.WriteString(fmt.Sprintf("[%s]", color.CSS()))
+	for _, count := range counts {
+		if count == 0 {
+			line.WriteString("[#334155]" + string(sparkBlocks[0]) + fmt.Sprintf("[%s]", color.CSS()))
+			continue
+		}
+		level := int((count*int64(len(sparkBlocks)) - 1) / peak)
+		line.WriteRune(sparkBlocks[level])
+	}
+	line.WriteString("[white]")
+	return line.String()
+}
+
+// perMinute converts a bucket count into a per-minute rate
+func perMinute(count int64, bucket time.Duration) float64 {
+	return float64(count) / bucket.Minutes()
+}
+
+// SetPoints stores freshly fetched series
+func (cs *ChartState) SetPoints(points []client.SeriesPoint) {
+	cs.points = points
+	cs.fetchedAt = time.Now()
+}
+
+// fetchChartSeries queries the series for a chart window
+func fetchChartSeries(ctx context.Context, cli DataSource, window chartWindow) ([]client.SeriesPoint, error) {
+	return cli.JobSeries(ctx, window.window, window.bucket())
+}
+
+// loadCharts fetches the series in the background when stale and renders the chart view
+func (m *MonitorApp) loadCharts() {
+	if !m.charts.Stale() {
+		m.fetcher.Cancel(viewFetch)
+		m.renderCharts()
+		return
+	}
+
+	cli := m.client
+	window := m.charts.Window()
+	m.load(func(ctx context.Context) (func(), error) {
+		points, err := fetchChartSeries(ctx, cli, window)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			m.charts.SetPoints(points)
+			m.renderCharts()
+		}, nil
+	})
+}
+
+// renderCharts draws the overall sparklines and the per-kind breakdown of the selected metric
+func (m *MonitorApp) renderCharts() {
+	window := m.charts.Window()
+	bucket := window.bucket()
+	metric := m.charts.Metric()
+
+	var text strings.Builder
+	text.WriteString("[#94A3B8]Window:[white]")
+	for i, w := range chartWindows {
+		if i == m.charts.windowIndex {
+			text.WriteString(fmt.Sprintf(" [#60A5FA]%s[white]", tview.Escape("["+w.label+"]")))
+		} else {
+			text.WriteString(fmt.Sprintf(" [#64748B]%s[white]", w.label))
+		}
+	}
+	text.WriteString(fmt.Sprintf("   [#94A3B8]Resolution:[white] %s per point   [#94A3B8]Updated:[white] %s\n\n",
+		formatDuration(bucket), m.charts.fetchedAt.Format("15:04:05")))
+
+	totals := buildSeries(m.charts.points, func(p client.SeriesPoint) (string, bool) { return p.Metric, true })
+	text.WriteString("[#60A5FA]All Kinds[white]\n")
+	text.WriteString(fmt.Sprintf("  [#94A3B8]%-10s %-*s %10s %10s %10s[white]\n", "METRIC", chartPoints, "", "TOTAL", "LAST/MIN", "PEAK/MIN"))
+	for _, overall := range chartMetrics {
+		s, ok := totals[overall.name]
+		if !ok {
+			s = &series{counts: make([]int64, chartPoints)}
+		}
+		writeSeriesRow(&text, overall.label, 10, s, *overall.color, bucket)
+	}
+	text.WriteString("\n")
+
+	kinds := buildSeries(m.charts.points, func(p client.SeriesPoint) (string, bool) {
+		return p.Kind, p.Metric == metric.name
+	})
+	byTotal := make([]*series, 0, len(kinds))
+	for _, s := range kinds {
+		byTotal = append(byTotal, s)
+	}
+	sort.Slice(byTotal, func(i, j int) bool {
+		if byTotal[i].total != byTotal[j].total {
+			return byTotal[i].total > byTotal[j].total
+		}
+		return byTotal[i].name < byTotal[j].name
+	})
+
+	text.WriteString(fmt.Sprintf("[#60A5FA]%s by Kind[white]", metric.label))
+	if len(byTotal) > chartMaxKinds {
+		text.WriteString(fmt.Sprintf(" (top %d of %d)", chartMaxKinds, len(byTotal)))
+		byTotal = byTotal[:chartMaxKinds]
+	}
+	text.WriteString("\n")
+	if len(byTotal) == 0 {
+		text.WriteString(fmt.Sprintf("  No %s jobs in the last %s\n", strings.ToLower(metric.label), window.label))
+	} else {
+		nameWidth := len("KIND")
+		for _, s := range byTotal {
+			nameWidth = max(nameWidth, displayWidth(s.name))
+		}
+		nameWidth = min(nameWidth, 40)
+		text.WriteString(fmt.Sprintf("  [#94A3B8]%-*s %-*s %10s %10s %10s[white]\n", nameWidth, "KIND", chartPoints, "", "TOTAL", "LAST/MIN", "PEAK/MIN"))
+		for _, s := range byTotal {
+			writeSeriesRow(&text, s.name, nameWidth, s, *metric.color, bucket)
+		}
+	}
+
+	m.ui.charts.SetText(text.String())
+}
+
+// writeSeriesRow renders one labelled sparkline with its total, latest and
+// peak rates, fitting the label to the width
+func writeSeriesRow(text *strings.Builder, label string, width int, s *series, color tcell.Color, bucket time.Duration) {
+	var peak int64
+	for _, count := range s.counts {
+		peak = max(peak, count)
+	}
+	text.WriteString(fmt.Sprintf("  %s %s %10d %10.1f %10.1f\n",
+		fitName(label, width),
+		sparkline(s.counts, color),
+		s.total,
+		perMinute(s.counts[len(s.counts)-1], bucket),
+		perMinute(peak, bucket)))
+}
+
+// showCharts switches to the chart view
+func (m *MonitorApp) showCharts() {
+	m.ui.pages.SwitchToPage(PageCharts)
+	m.ui.app.SetFocus(m.ui.charts)
+	m.setChartsModeStatus()
+	m.loadCharts()
+}
+
+func (m *MonitorApp) setChartsModeStatus() {
+	m.setModeStatus("Charts", []string{"w: Window", "m: Kind metric", "Esc: Back to jobs", "Ctrl+D: Dashboard"}, nil)
+}
@@ -0,0 +1,24 @@
+package monitor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+func TestWriteSeriesRowAlignsNonASCIILabels(t *testing.T) {
+	counts := []int64{0, 3, 1, 4}
+	var want int
+	for i, label := range []string{"EmailJob", "RapportÉté", "日本語ジョブ", "Job" + strings.Repeat("é", 40)} {
+		var text strings.Builder
+		writeSeriesRow(&text, label, 12, &series{name: label, counts: counts, total: 8}, ColorSuccess, time.Minute)
+		width := tview.TaggedStringWidth(strings.TrimSuffix(text.String(), "\n"))
+		if i == 0 {
+			want = width
+		} else if width != want {
+			t.Errorf("row of %q takes %d cells, want %d", label, width, want)
+		}
+	}
+}
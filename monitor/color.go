@@ -26,16 +26,87 @@ var (
 	ColorContrastSecondary      = getEnvColor("RIVER_COLOR_CONTRAST_SECONDARY", tcell.NewRGBColor(203, 213, 225))
 	ColorSelectedFg             = getEnvColor("RIVER_COLOR_SELECTED_FG", tcell.ColorWhite)
 	ColorSelectedBg             = getEnvColor("RIVER_COLOR_SELECTED_BG", tcell.NewRGBColor(30, 58, 138))
+	ColorMarkedBg               = getEnvColor("RIVER_COLOR_MARKED_BG", tcell.NewRGBColor(76, 29, 149))
 	ColorPrimativeBackground    = getEnvColor("RIVER_COLOR_PRIMATIVE_BACKGROUND", tcell.ColorBlack)
 	ColorContrastBackground     = getEnvColor("RIVER_COLOR_CONTRAST_BACKGROUND", tcell.NewRGBColor(30, 41, 59))
 	ColorMoreContrastBackground = getEnvColor("RIVER_COLOR_MORE_CONTRAST_BACKGROUND", tcell.NewRGBColor(30, 41, 59))
 )
 
+// defaultColors holds the colors set by the environment, before any profile theme
+var defaultColors = make(map[string]tcell.Color)
+
 func init() {
 	if envVar := os.Getenv("RIVER_COLOR_TRANSPARENT_BG"); strings.ToLower(envVar) == "true" {
 		ColorContrastBackground = tcell.ColorDefault
 		ColorPrimativeBackground = tcell.ColorDefault
 	}
+	for key, color := range themeColors {
+		defaultColors[key] = *color
+	}
+}
+
+// themeColors maps theme keys to the colors they override
+var themeColors = map[string]*tcell.Color{
+	"primary":                  &ColorPrimary,
+	"secondary":                &ColorSecondary,
+	"tertiary":                 &ColorTertiary,
+	"border":                   &ColorBorder,
+	"warning":                  &ColorWarning,
+	"info":                     &ColorInfo,
+	"success":                  &ColorSuccess,
+	"error":                    &ColorError,
+	"available":                &ColorAvailable,
+	"cancelled":                &ColorCancelled,
+	"retryable":                &ColorRetryable,
+	"scheduled":                &ColorScheduled,
+	"title":                    &ColorTitle,
+	"contrast_secondary":       &ColorContrastSecondary,
+	"selected_fg":              &ColorSelectedFg,
+	"selected_bg":              &ColorSelectedBg,
+	"marked_bg":                &ColorMarkedBg,
+	"primative_background":     &ColorPrimativeBackground,
+	"contrast_background":      &ColorContrastBackground,
+	"more_contrast_background": &ColorMoreContrastBackground,
+}
+
+// applyTheme overrides colors from a config profile theme. Keys match the
+// RIVER_COLOR_ environment variables in lowercase without the prefix, and the
+// environment variables take precedence.
+func applyTheme(theme map[string]string) {
+	for key, val := range theme {
+		key = strings.ToLower(key)
+		envName := "RIVER_COLOR_" + strings.ToUpper(key)
+		if os.Getenv(envName) != "" {
+			continue
+		}
+
+		if key == "transparent_bg" {
+			if strings.ToLower(val) == "true" {
+				ColorContrastBackground = tcell.ColorDefault
+				ColorPrimativeBackground = tcell.ColorDefault
+			}
+			continue
+		}
+
+		color, ok := themeColors[key]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown theme color %s, ignoring\n", key)
+			continue
+		}
+		c, err := parseHexColor(val)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to parse theme color %s %s, ignoring\n", key, val)
+			continue
+		}
+		*color = c
+	}
+}
+
+// resetTheme restores the colors set by the environment, undoing a profile theme
+func resetTheme() {
+	for key, color := range defaultColors {
+		*themeColors[key] = color
+	}
 }
 
 func parseHexColor(s string) (tcell.Color, error) {
@@ -0,0 +1,261 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/rivo/tview"
+)
+
+const (
+	// completionFetch is the fetch key of the kinds and queues offered by the search prompt
+	completionFetch = "completions"
+	// maxCompletions is the number of entries shown in the autocomplete drop-down
+	maxCompletions = 10
+)
+
+// SearchCompleter offers the known kinds and queues while a search
+// expression is typed, matching the term being typed fuzzily
+type SearchCompleter struct {
+	names client.JobNames
+	// completions are the texts of the input for the entries of the drop-down
+	completions []string
+	// shown is whether the drop-down is open
+	shown bool
+}
+
+// SetNames replaces the kinds and queues offered
+func (sc *SearchCompleter) SetNames(names client.JobNames) {
+	sc.names = names
+}
+
+// Complete returns the entries for the term at the end of the text: kinds
+// for a bare word or kind:, included or excluded, queues for queue:, and
+// nothing for other terms
+func (sc *SearchCompleter) Complete(text string) []string {
+	sc.completions = nil
+	sc.shown = false
+	if last, _ := utf8.DecodeLastRuneInString(text); text == "" || unicode.IsSpace(last) {
+		return nil
+	}
+	tokens, err := tokenizeSearch(text)
+	if err != nil || len(tokens) == 0 {
+		return nil
+	}
+	last := tokens[len(tokens)-1]
+	if strings.HasPrefix(last.text, `"`) {
+		return nil
+	}
+
+	prefix := text[:last.offset]
+	term := last.text
+	if excluded, ok := strings.CutPrefix(term, "-"); ok {
+		prefix += "-"
+		term = excluded
+	}
+	var candidates []string
+	field, op, value := splitTerm(term)
+	switch {
+	case op == "":
+		candidates = sc.names.Kinds
+	case (field == "kind" || field == "queue") && (op == ":" || op == "=") || field == "kind" && op == "!=":
+		candidates = sc.names.Kinds
+		if field == "queue" {
+			candidates = sc.names.Queues
+		}
+		// Complete the last value of a list, keeping the ones before it
+		end := strings.LastIndex(term, ",")
+		if end < 0 {
+			end = len(field + op)
+		} else {
+			end++
+		}
+		prefix += term[:end]
+		value = term[end:]
+	default:
+		return nil
+	}
+	if client.IsPattern(value) {
+		return nil
+	}
+
+	matches := fuzzyFilter(value, candidates)
+	if len(matches) == 0 || (len(matches) == 1 && matches[0] == value) {
+		return nil
+	}
+	if len(matches) > maxCompletions {
+		matches = matches[:maxCompletions]
+	}
+	for _, match := range matches {
+		sc.completions = append(sc.completions, prefix+match)
+	}
+	sc.shown = true
+	return matches
+}
+
+// Accept returns the text of the input with the entry at the index chosen,
+// and closes the drop-down
+func (sc *SearchCompleter) Accept(index int) string {
+	sc.shown = false
+	if index < 0 || index >= len(sc.completions) {
+		return ""
+	}
+	return sc.completions[index]
+}
+
+// Dismiss closes the drop-down without choosing an entry
+func (sc *SearchCompleter) Dismiss() {
+	sc.shown = false
+}
+
+// Suggest returns the known name closest to a kind or queue no job uses, if
+// it is close enough to be a typo
+func (sc *SearchCompleter) Suggest(value string, queue bool) string {
+	candidates := sc.names.Kinds
+	if queue {
+		candidates = sc.names.Queues
+	}
+	best, bestDistance := "", max(2, len(value)/4)+1
+	for _, candidate := range candidates {
+		if distance := editDistance(strings.ToLower(value), strings.ToLower(candidate)); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// editDistance returns the number of runes to insert, delete or replace to turn a into b
+func editDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := range source {
+		current[0] = i + 1
+		for j := range target {
+			cost := 1
+			if source[i] == target[j] {
+				cost = 0
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
+// fuzzyFilter returns the candidates matching the pattern, best matches
+// first, or all of them in order for an empty pattern
+func fuzzyFilter(pattern string, candidates []string) []string {
+	if pattern == "" {
+		return slices.Clone(candidates)
+	}
+	scores := make(map[string]int)
+	var matches []string
+	for _, candidate := range candidates {
+		if score := fuzzyScore(pattern, candidate); score >= 0 {
+			scores[candidate] = score
+			matches = append(matches, candidate)
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b string) int {
+		if scores[a] != scores[b] {
+			return scores[b] - scores[a]
+		}
+		return len(a) - len(b)
+	})
+	return matches
+}
+
+// fuzzyScore scores how well the pattern matches the candidate as a
+// subsequence ignoring case, or returns -1 when it doesn't match. Runs of
+// consecutive characters and characters starting a word score higher.
+func fuzzyScore(pattern, candidate string) int {
+	want := []rune(strings.ToLower(pattern))
+	runes := []rune(candidate)
+	score, matched, previous := 0, 0, -2
+	for i := 0; i < len(runes) && matched < len(want); i++ {
+		if unicode.ToLower(runes[i]) != want[matched] {
+			continue
+		}
+		score++
+		if i == previous+1 {
+			score += 4
+		}
+		if i == 0 || startsWord(runes[i-1], runes[i]) {
+			score += 3
+		}
+		previous = i
+		matched++
+	}
+	if matched < len(want) {
+		return -1
+	}
+	return score
+}
+
+// startsWord reports whether r starts a word after prev, as in send_email,
+// main.SendEmail or send-email
+func startsWord(prev, r rune) bool {
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev) ||
+		unicode.IsLower(prev) && unicode.IsUpper(r)
+}
+
+// completeNames returns the names fuzzily matching the text of a form field.
+// Forms call it with the names of the current completer, which a profile
+// switch replaces.
+func completeNames(text string, names []string) []string {
+	matches := fuzzyFilter(text, names)
+	// Nothing is left to complete once the name is typed in full
+	if len(matches) == 1 && matches[0] == text {
+		return nil
+	}
+	return matches
+}
+
+// loadSearchCompletions refreshes the kinds and queues offered by the search prompt
+func (m *MonitorApp) loadSearchCompletions() {
+	cli := m.client
+	completer := m.completer
+	m.fetcher.Fetch(completionFetch, func(ctx context.Context) (func(), error) {
+		names, err := cli.JobNames(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list job kinds and queues: %w", err)
+		}
+		return func() { completer.SetNames(names) }, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: %v[white]", err))
+	})
+}
+
+// warnUnknownNames points out kinds and queues of the search that no job
+// uses, which would otherwise just show an empty list
+func (m *MonitorApp) warnUnknownNames(search *Search) {
+	check := func(field string, values, known []string) bool {
+		if len(known) == 0 {
+			return false
+		}
+		for _, value := range values {
+			if client.IsPattern(value) || slices.Contains(known, value) {
+				continue
+			}
+			warning := fmt.Sprintf("[yellow]No job has %s %s", field, tview.Escape(value))
+			if suggestion := m.completer.Suggest(value, field == "queue"); suggestion != "" {
+				warning += fmt.Sprintf(", did you mean %s?", tview.Escape(suggestion))
+			}
+			m.ui.statusBar.SetText(warning + "[white]")
+			return true
+		}
+		return false
+	}
+	if !check("kind", search.Kinds, m.completer.names.Kinds) {
+		check("queue", search.Queues, m.completer.names.Queues)
+	}
+}
@@ -0,0 +1,47 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/almottier/rivertui/internal/client"
+)
+
+func TestSearchCompleter(t *testing.T) {
+	completer := &SearchCompleter{}
+	completer.SetNames(client.JobNames{Kinds: []string{"EmailJob", "ReportJob"}, Queues: []string{"mail", "reports"}})
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Ema", "EmailJob"},
+		{"-Ema", "-EmailJob"},
+		{"kind:ReportJob,Ema", "kind:ReportJob,EmailJob"},
+		{"kind!=Ema", "kind!=EmailJob"},
+		{"queue:rep", "queue:reports"},
+		{`"café" Ema`, `"café" EmailJob`},
+		{"args~é kind:Ema", "args~é kind:EmailJob"},
+		{"état:x -Rep", "état:x -ReportJob"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if matches := completer.Complete(tt.text); len(matches) == 0 {
+				t.Fatalf("no completions")
+			}
+			if got := completer.Accept(0); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchCompleterNothingToComplete(t *testing.T) {
+	completer := &SearchCompleter{}
+	completer.SetNames(client.JobNames{Kinds: []string{"EmailJob"}, Queues: []string{"mail"}})
+
+	for _, text := range []string{"", "Ema ", "café ", `"Ema`, "EmailJob", "state:ava", "Email*", "args~Ema"} {
+		if matches := completer.Complete(text); len(matches) != 0 {
+			t.Errorf("%q completed to %v", text, matches)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/gdamore/tcell/v2"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
+)
+
+const dashboardMaxKinds = 20
+
+// dashboardStates are the states shown in the dashboard breakdowns, in lifecycle order
+var dashboardStates = []rivertype.JobState{
+	rivertype.JobStateAvailable,
+	rivertype.JobStateRunning,
+	rivertype.JobStateScheduled,
+	rivertype.JobStateRetryable,
+	rivertype.JobStateDiscarded,
+	rivertype.JobStateCancelled,
+	rivertype.JobStateCompleted,
+}
+
+// dashboardWindows are the throughput windows shown in the dashboard
+var dashboardWindows = []time.Duration{time.Minute, 5 * time.Minute, time.Hour}
+
+// stateBreakdown holds job counts per state for one queue or kind
+type stateBreakdown struct {
+	name   string
+	counts map[rivertype.JobState]int64
+	total  int64
+}
+
+// groupCounts aggregates state counts by the key returned for each count, largest total first
+func groupCounts(counts []client.StateCount, key func(client.StateCount) string) []*stateBreakdown {
+	groups := make(map[string]*stateBreakdown)
+	for _, count := range counts {
+		name := key(count)
+		group, ok := groups[name]
+		if !ok {
+			group = &stateBreakdown{name: name, counts: make(map[rivertype.JobState]int64)}
+			groups[name] = group
+		}
+		group.counts[count.State] += count.Count
+		group.total += count.Count
+	}
+
+	result := make([]*stateBreakdown, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].total != result[j].total {
+			return result[i].total > result[j].total
+		}
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+// dashboardData holds the statistics shown on the dashboard
+type dashboardData struct {
+	counts     []client.StateCount
+	throughput []client.Throughput
+	latencies  []client.QueueLatency
+}
+
+// fetchDashboard queries the statistics shown on the dashboard
+func fetchDashboard(ctx context.Context, cli DataSource) (*dashboardData, error) {
+	counts, err := cli.JobCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	throughput, err := cli.JobThroughput(ctx, dashboardWindows)
+	if err != nil {
+		return nil, err
+	}
+	latencies, err := cli.QueueLatencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &dashboardData{counts: counts, throughput: throughput, latencies: latencies}, nil
+}
+
+// loadDashboard refreshes the dashboard with counts, throughput and queue latency in the background
+func (m *MonitorApp) loadDashboard() {
+	cli := m.client
+	m.load(func(ctx context.Context) (func(), error) {
+		data, err := fetchDashboard(ctx, cli)
+		if err != nil {
+			return nil, err
+		}
+		return func() { m.renderDashboard(data) }, nil
+	})
+}
+
+// renderDashboard displays the fetched statistics on the dashboard
+func (m *MonitorApp) renderDashboard(data *dashboardData) {
+	counts := data.counts
+	oldest := make(map[string]time.Duration, len(data.latencies))
+	for _, latency := range data.latencies {
+		oldest[latency.Queue] = latency.OldestAvailable
+	}
+
+	var text strings.Builder
+	writeStateTotals(&text, counts)
+	writeThroughput(&text, data.throughput)
+
+	queues := groupCounts(counts, func(c client.StateCount) string { return c.Queue })
+	text.WriteString("[#60A5FA]Queues[white]\n")
+	writeBreakdown(&text, "QUEUE", queues, func(name string) string {
+		if age, ok := oldest[name]; ok {
+			return formatDuration(age)
+		}
+		return "-"
+	})
+	text.WriteString("\n")
+
+	kinds := groupCounts(counts, func(c client.StateCount) string { return c.Kind })
+	if len(kinds) > dashboardMaxKinds {
+		text.WriteString(fmt.Sprintf("[#60A5FA]Kinds[white] (top %d of %d)\n", dashboardMaxKinds, len(kinds)))
+		kinds = kinds[:dashboardMaxKinds]
+	} else {
+		text.WriteString("[#60A5FA]Kinds[white]\n")
+	}
+	writeBreakdown(&text, "KIND", kinds, nil)
+
+	m.ui.dashboard.SetText(text.String())
+}
+
+func writeStateTotals(text *strings.Builder, counts []client.StateCount) {
+	totals := make(map[rivertype.JobState]int64)
+	var total int64
+	for _, count := range counts {
+		totals[count.State] += count.Count
+		total += count.Count
+	}
+
+	text.WriteString("[#60A5FA]Jobs by State[white]\n")
+	for _, state := range dashboardStates {
+		text.WriteString(fmt.Sprintf("  %-12s %s\n", state, countCell(totals[state], 10, stateColor(state))))
+	}
+	text.WriteString(fmt.Sprintf("  %-12s %10d\n\n", "total", total))
+}
+
+func writeThroughput(text *strings.Builder, throughput []client.Throughput) {
+	text.WriteString("[#60A5FA]Throughput[white]\n")
+	text.WriteString(fmt.Sprintf("  [#94A3B8]%-8s %10s %10s %14s %11s[white]\n", "WINDOW", "COMPLETED", "FAILED", "COMPLETED/MIN", "FAILED/MIN"))
+	for _, t := range throughput {
+		minutes := t.Window.Minutes()
+		text.WriteString(fmt.Sprintf("  %-8s %s %s %14.1f %11.1f\n",
+			formatDuration(t.Window),
+			countCell(t.Completed, 10, ColorSuccess),
+			countCell(t.Failed, 10, ColorError),
+			float64(t.Completed)/minutes,
+			float64(t.Failed)/minutes))
+	}
+	text.WriteString("\n")
+}
+
+// writeBreakdown renders per-state counts for each group, with an optional extra column
+func writeBreakdown(text *strings.Builder, label string, groups []*stateBreakdown, extra func(name string) string) {
+	if len(groups) == 0 {
+		text.WriteString("  No jobs\n")
+		return
+	}
+
+	nameWidth := displayWidth(label)
+	for _, group := range groups {
+		nameWidth = max(nameWidth, displayWidth(group.name))
+	}
+	nameWidth = min(nameWidth, 48)
+
+	text.WriteString(fmt.Sprintf("  [#94A3B8]%-*s", nameWidth, label))
+	for _, state := range dashboardStates {
+		text.WriteString(fmt.Sprintf(" %10s", strings.ToUpper(string(state))))
+	}
+	if extra != nil {
+		text.WriteString(fmt.Sprintf(" %16s", "OLDEST AVAILABLE"))
+	}
+	text.WriteString("[white]\n")
+
+	for _, group := range groups {
+		text.WriteString("  " + fitName(group.name, nameWidth))
+		for _, state := range dashboardStates {
+			text.WriteString(" " + countCell(group.counts[state], 10, stateColor(state)))
+		}
+		if extra != nil {
+			text.WriteString(fmt.Sprintf(" %16s", extra(group.name)))
+		}
+		text.WriteString("\n")
+	}
+}
+
+// fitName escapes the name for a text view, cut to the display width with
+// an ellipsis and padded to it
+func fitName(name string, width int) string {
+	if displayWidth(name) > width {
+		runes := []rune(name)
+		for len(runes) > 0 && displayWidth(string(runes))+1 > width {
+			runes = runes[:len(runes)-1]
+		}
+		name = string(runes) + "…"
+	}
+	return tview.Escape(name) + strings.Repeat(" ", max(0, width-displayWidth(name)))
+}
+
+// displayWidth returns the number of screen cells the text takes
+func displayWidth(text string) int {
+	return tview.TaggedStringWidth(tview.Escape(text))
+}
+
+// countCell right-aligns a count, coloring it when non-zero
+func countCell(count int64, width int, color tcell.Color) string {
+	if count == 0 {
+		return fmt.Sprintf("[#64748B]%*d[white]", width, count)
+	}
+	return fmt.Sprintf("[%s]%*d[white]", color.CSS(), width, count)
+}
+
+// ShowDashboard opens the dashboard, used to start on it instead of the job list
+func (m *MonitorApp) ShowDashboard() {
+	m.showDashboard()
+}
+
+// showDashboard switches to the dashboard page
+func (m *MonitorApp) showDashboard() {
+	m.ui.pages.SwitchToPage(PageDashboard)
+	m.ui.app.SetFocus(m.ui.dashboard)
+	m.setDashboardModeStatus()
+	m.loadDashboard()
+}
+
+func (m *MonitorApp) setDashboardModeStatus() {
+	m.setModeStatus("Dashboard", []string{"Esc: Back to jobs", "Ctrl+Q: View queues", "Ctrl+T: Charts"}, nil)
+}
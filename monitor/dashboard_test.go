@@ -0,0 +1,51 @@
+package monitor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rivo/tview"
+)
+
+func TestFitName(t *testing.T) {
+	tests := []struct {
+		name  string
+		width int
+		want  string
+	}{
+		{"mail", 6, "mail  "},
+		{"reports", 6, "repor…"},
+		{"café", 6, "café  "},
+		{"crème.brûlée", 8, "crème.b…"},
+		{"日本語キュー", 7, "日本語…"},
+		{"[red]queue", 8, "[red[]qu…"},
+	}
+	for _, tt := range tests {
+		got := fitName(tt.name, tt.width)
+		if got != tt.want {
+			t.Errorf("fitName(%q, %d) = %q, want %q", tt.name, tt.width, got, tt.want)
+		}
+		if width := tview.TaggedStringWidth(got); width != tt.width {
+			t.Errorf("fitName(%q, %d) takes %d cells", tt.name, tt.width, width)
+		}
+	}
+}
+
+func TestWriteBreakdownAlignsNonASCIINames(t *testing.T) {
+	groups := []*stateBreakdown{
+		{name: "mail"},
+		{name: "café"},
+		{name: "日本語キュー"},
+		{name: "queue-" + strings.Repeat("é", 60)},
+	}
+	var text strings.Builder
+	writeBreakdown(&text, "QUEUE", groups, nil)
+
+	lines := strings.Split(strings.TrimSuffix(text.String(), "\n"), "\n")
+	want := tview.TaggedStringWidth(lines[0])
+	for _, line := range lines[1:] {
+		if width := tview.TaggedStringWidth(line); width != want {
+			t.Errorf("row %q takes %d cells, want %d like the header", line, width, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/almottier/rivertui/config"
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// DataSource provides the jobs, queues and statistics shown by the monitor.
+// client.Client implements it on top of Postgres; memory.Source implements it
+// in memory so the monitor can run without a database.
+type DataSource interface {
+	JobList(ctx context.Context, query client.JobListQuery) ([]*rivertype.JobRow, error)
+	JobGet(ctx context.Context, id int64) (*rivertype.JobRow, error)
+	JobRetry(ctx context.Context, id int64) (*rivertype.JobRow, error)
+	JobCancel(ctx context.Context, id int64) (*rivertype.JobRow, error)
+	JobDelete(ctx context.Context, id int64) (*rivertype.JobRow, error)
+	JobInsert(ctx context.Context, params client.JobInsertParams) (*rivertype.JobInsertResult, error)
+	JobUpdate(ctx context.Context, id int64, params client.JobUpdateParams) (*rivertype.JobRow, error)
+
+	// The batch operations change the jobs among the IDs their state allows
+	// in one round-trip, and return how many they changed
+	JobRetryMany(ctx context.Context, ids []int64) (int, error)
+	JobCancelMany(ctx context.Context, ids []int64) (int, error)
+	JobDeleteMany(ctx context.Context, ids []int64) (int, error)
+	JobUpdateMany(ctx context.Context, ids []int64, params client.JobUpdateParams) (int, error)
+
+	QueueList(ctx context.Context, limit int) ([]*rivertype.Queue, error)
+	QueuePause(ctx context.Context, name string) error
+	QueueResume(ctx context.Context, name string) error
+
+	JobCounts(ctx context.Context) ([]client.StateCount, error)
+	JobNames(ctx context.Context) (client.JobNames, error)
+	JobThroughput(ctx context.Context, windows []time.Duration) ([]client.Throughput, error)
+	QueueLatencies(ctx context.Context) ([]client.QueueLatency, error)
+	JobSeries(ctx context.Context, window, bucket time.Duration) ([]client.SeriesPoint, error)
+
+	// Listen subscribes to change notifications on the channels
+	Listen(ctx context.Context, channels ...string) (client.Subscription, error)
+	Close()
+}
+
+var _ DataSource = (*client.Client)(nil)
+
+// connectPostgres opens a client for the configuration, used when switching profiles
+func connectPostgres(ctx context.Context, cfg *config.Config) (DataSource, error) {
+	cli, err := client.New(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
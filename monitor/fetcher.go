@@ -0,0 +1,170 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+const (
+	// viewFetch is the fetch key of the data shown on the front page. Loading
+	// a page supersedes the fetch of the page shown before it.
+	viewFetch = "view"
+
+	fetchTimeout = 10 * time.Second
+	// loadingDelay keeps fast fetches from flashing the loading indicator
+	loadingDelay = 250 * time.Millisecond
+)
+
+// fetchQuery runs off the UI goroutine and returns a func that applies its
+// result on the UI goroutine
+type fetchQuery func(ctx context.Context) (apply func(), err error)
+
+type fetchRequest struct {
+	id      int
+	cancel  context.CancelFunc
+	started time.Time
+}
+
+// fetcher runs queries in background goroutines with a timeout and posts
+// their results back to the UI goroutine. Starting a query for a key cancels
+// the one still running for it, so only the latest request of a view is
+// applied. Its methods must be called on the UI goroutine.
+type fetcher struct {
+	app         *tview.Application
+	timeout     time.Duration
+	nextID      int
+	inflight    map[string]*fetchRequest
+	failed      map[string]bool
+	lastSuccess map[string]time.Time
+	onChange    func()
+}
+
+func newFetcher(app *tview.Application, timeout time.Duration) *fetcher {
+	return &fetcher{
+		app:         app,
+		timeout:     timeout,
+		inflight:    make(map[string]*fetchRequest),
+		failed:      make(map[string]bool),
+		lastSuccess: make(map[string]time.Time),
+		onChange:    func() {},
+	}
+}
+
+// Fetch runs the query in the background, replacing the query running for the key.
+// onError is called on the UI goroutine if the query fails or times out.
+func (f *fetcher) Fetch(key string, query fetchQuery, onError func(error)) {
+	f.Cancel(key)
+
+	f.nextID++
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	request := &fetchRequest{id: f.nextID, cancel: cancel, started: time.Now()}
+	f.inflight[key] = request
+	time.AfterFunc(loadingDelay, func() { f.app.QueueUpdateDraw(f.onChange) })
+
+	go func() {
+		apply, err := f.run(ctx, query)
+		f.app.QueueUpdateDraw(func() {
+			if current := f.inflight[key]; current == nil || current.id != request.id {
+				// Superseded or cancelled
+				return
+			}
+			delete(f.inflight, key)
+			cancel()
+
+			f.failed[key] = err != nil
+			if err != nil {
+				onError(err)
+			} else {
+				f.lastSuccess[key] = time.Now()
+				apply()
+			}
+			f.onChange()
+		})
+	}()
+}
+
+// Do runs a query that no later request supersedes, such as a mutation
+func (f *fetcher) Do(query fetchQuery, onError func(error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	go func() {
+		defer cancel()
+		apply, err := f.run(ctx, query)
+		f.app.QueueUpdateDraw(func() {
+			if err != nil {
+				onError(err)
+			} else {
+				apply()
+			}
+		})
+	}()
+}
+
+// run runs the query, reporting a timeout as such
+func (f *fetcher) run(ctx context.Context, query fetchQuery) (func(), error) {
+	apply, err := query(ctx)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %s", f.timeout)
+	}
+	return apply, err
+}
+
+// Cancel cancels the query running for the key, discarding its result
+func (f *fetcher) Cancel(key string) {
+	if request, ok := f.inflight[key]; ok {
+		request.cancel()
+		delete(f.inflight, key)
+		f.onChange()
+	}
+}
+
+// Loading reports whether a query is running for the key
+func (f *fetcher) Loading(key string) bool {
+	_, ok := f.inflight[key]
+	return ok
+}
+
+// LoadingFor returns how long the query for the key has been running
+func (f *fetcher) LoadingFor(key string) time.Duration {
+	if request, ok := f.inflight[key]; ok {
+		return time.Since(request.started)
+	}
+	return 0
+}
+
+// Stale reports whether the last query for the key failed, along with the
+// time of the last successful one
+func (f *fetcher) Stale(key string) (bool, time.Time) {
+	return f.failed[key], f.lastSuccess[key]
+}
+
+// updateFetchIndicator shows whether the front page is loading, stale or up to date
+func (m *MonitorApp) updateFetchIndicator() {
+	stale, lastSuccess := m.fetcher.Stale(viewFetch)
+	switch {
+	case m.fetcher.LoadingFor(viewFetch) >= loadingDelay:
+		m.ui.fetchIndicator.SetText("[#60A5FA]⟳ Loading…[white]")
+	case stale && lastSuccess.IsZero():
+		m.ui.fetchIndicator.SetText("[#F59E0B]⚠ No data[white]")
+	case stale:
+		m.ui.fetchIndicator.SetText(fmt.Sprintf("[#F59E0B]⚠ Stale since %s[white]", lastSuccess.Format("15:04:05")))
+	case !lastSuccess.IsZero():
+		m.ui.fetchIndicator.SetText(fmt.Sprintf("[#64748B]Updated %s[white]", lastSuccess.Format("15:04:05")))
+	default:
+		m.ui.fetchIndicator.SetText("")
+	}
+}
+
+// load fetches data for the front page, reporting failures in the status bar
+func (m *MonitorApp) load(query fetchQuery) {
+	m.loadOr(query, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: %v[white]", err))
+	})
+}
+
+// loadOr fetches data for the front page, handling failures with onError
+func (m *MonitorApp) loadOr(query fetchQuery, onError func(error)) {
+	m.fetcher.Fetch(viewFetch, query, onError)
+}
@@ -5,35 +5,53 @@ import (
 	"strings"
 
 	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
 )
 
 // updateFilterStatusBar updates the filter status bar with numbered options
 func (m *MonitorApp) updateFilterStatusBar() {
 	var text strings.Builder
 
-	// Search kind/id filter information
+	// Search kind and expression information
 	text.WriteString("[#60A5FA]Search:[white] ")
-	if len(m.filter.kindFilter) > 0 {
-		text.WriteString(fmt.Sprintf("[#3B82F6]%s[white]", m.filter.kindFilter[0]))
+	if search := m.filter.SearchSummary(); search != "" {
+		text.WriteString(fmt.Sprintf("[#3B82F6]%s[white]", tview.Escape(search)))
 	} else {
 		text.WriteString("All")
 	}
 	text.WriteString(" ([#60A5FA]/[white])")
 
-	text.WriteString(" | [#60A5FA]State:[white] ")
+	text.WriteString(" | [#60A5FA]Queue:[white] ")
+	if len(m.filter.queueFilter) > 0 {
+		text.WriteString(fmt.Sprintf("[#3B82F6]%s[white]", tview.Escape(compactList(m.filter.queueFilter, ""))))
+	} else {
+		text.WriteString("All")
+	}
+	text.WriteString(" ([#60A5FA]Q[white])")
 
-	// State filter information
+	// State filter information, each selected state in brackets
+	if m.stateToggle {
+		text.WriteString(" | [#60A5FA]State [#F59E0B](toggle)[#60A5FA]:[white] ")
+	} else {
+		text.WriteString(" | [#60A5FA]State:[white] ")
+	}
 	for i, state := range m.filter.stateConfig.Labels {
 		if i > 0 {
 			text.WriteString(" | ")
 		}
-		if i == m.filter.selectedStateNum {
+		selected := len(m.filter.stateFilter) == 0
+		if i > 0 {
+			selected = m.filter.HasState(m.filter.stateConfig.States[i-1])
+		}
+		if selected {
 			text.WriteString(fmt.Sprintf("[#3B82F6][[%d:%s]][white]", i, state))
 		} else {
 			text.WriteString(fmt.Sprintf("[#94A3B8]%d:%s[white]", i, state))
 		}
 	}
 
+	text.WriteString(fmt.Sprintf(" | [#60A5FA]Sort:[white] %s ([#60A5FA]s/S[white])", m.sort.Describe()))
+
 	m.ui.filterStatusBar.SetText(text.String())
 }
 
@@ -43,6 +61,43 @@ func (m *MonitorApp) setStateFilter(stateNum int) {
 	m.pagination.Reset()
 	m.scrollToBeginning = true
 	m.updateFilterStatusBar()
+	m.loadJobList()
+}
+
+// toggleStateFilter adds the numbered state to the state filter or removes it
+func (m *MonitorApp) toggleStateFilter(stateNum int) {
+	m.filter.ToggleState(stateNum)
+	m.pagination.Reset()
+	m.scrollToBeginning = true
+	m.updateFilterStatusBar()
+	m.loadJobList()
+}
+
+// toggleStateMode switches the number keys between selecting a single state and toggling states
+func (m *MonitorApp) toggleStateMode() {
+	m.stateToggle = !m.stateToggle
+	m.updateFilterStatusBar()
+}
+
+// nextSortColumn sorts the job list by the next sortable column
+func (m *MonitorApp) nextSortColumn() {
+	m.sort.NextColumn()
+	m.applySort()
+}
+
+// toggleSortDirection reverses the order of the job list
+func (m *MonitorApp) toggleSortDirection() {
+	m.sort.ToggleDirection()
+	m.applySort()
+}
+
+// applySort reloads the job list from its first page, since cursors of the
+// previous order don't apply to the new one
+func (m *MonitorApp) applySort() {
+	m.pagination.Reset()
+	m.scrollToBeginning = true
+	m.updateFilterStatusBar()
+	m.loadJobList()
 }
 
 // getStateByNumber returns the job state for a given number (0=All, 1=completed, etc.)
@@ -54,20 +109,45 @@ func (m *MonitorApp) getStateByNumber(num int) rivertype.JobState {
 func (m *MonitorApp) nextPage() {
 	if !m.pagination.NextPage() {
 		m.ui.statusBar.SetText("[yellow]No more pages available[white]")
+		return
 	}
+	m.loadJobList()
 }
 
 // previousPage navigates to the previous page if available
 func (m *MonitorApp) previousPage() {
 	if !m.pagination.PreviousPage() {
 		m.ui.statusBar.SetText("[yellow]Already on first page[white]")
+		return
+	}
+	m.loadJobList()
+}
+
+// setModeStatus renders the status bar key hints, leaving out mutating actions in read-only mode
+func (m *MonitorApp) setModeStatus(mode string, hints []string, mutations []string) {
+	var text strings.Builder
+	text.WriteString("[#60A5FA]Mode:[white] " + mode)
+	if m.config.ReadOnly {
+		text.WriteString(" [#F59E0B](read-only)[white]")
+	} else {
+		hints = append(hints, mutations...)
+	}
+	for _, hint := range hints {
+		text.WriteString(" | " + hint)
 	}
+	text.WriteString(" | q: Quit")
+	m.ui.statusBar.SetText(text.String())
 }
 
+// setListModeStatus shows the essential job list keys, the others being listed on the help page
 func (m *MonitorApp) setListModeStatus() {
-	m.ui.statusBar.SetText("[#60A5FA]Mode:[white] List | Enter: View details | Ctrl+Q: View queues | n: Next page | p: Prev page | r: Retry job | c: Cancel job | q: Quit")
+	m.setModeStatus("List",
+		[]string{"?: Help", "Enter: Details", "/: Search", "0-7: State", "n/p: Page"},
+		[]string{"r/c/d: Retry/Cancel/Delete"})
 }
 
 func (m *MonitorApp) setDetailsModeStatus() {
-	m.ui.statusBar.SetText("[#60A5FA]Mode:[white] Details | Enter/Esc: Back to list | r: Retry job | c: Cancel job | q: Quit")
+	m.setModeStatus("Details",
+		[]string{"?: Help", "Esc: Back", "E: Errors"},
+		[]string{"r/c/d: Retry/Cancel/Delete", "u: Reschedule"})
 }
@@ -0,0 +1,89 @@
+package monitor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keyHelp describes a key binding on the help page
+type keyHelp struct {
+	key      string
+	action   string
+	mutation bool // hidden in read-only mode
+}
+
+var listKeyHelp = []keyHelp{
+	{"Enter", "View job details", false},
+	{"/", "Search with a filter expression or jump to job ID", false},
+	{"0-7", "Filter by job state (0=All, 1=Completed, 2=Available, etc.)", false},
+	{"Alt+0-7", "Add or remove a state, to view several states together", false},
+	{"+", "Switch 0-7 between selecting and toggling states", false},
+	{"Q", "Filter by one or more queues", false},
+	{"s / S", "Sort by the next column / reverse the sort direction", false},
+	{"n / p", "Next / previous page", false},
+	{"Ctrl+Q", "View queues", false},
+	{"Ctrl+D", "View dashboard", false},
+	{"Ctrl+T", "View charts", false},
+	{"Ctrl+P", "Switch connection profile", false},
+	{"Space", "Mark/unmark selected job", true},
+	{"V", "Mark all jobs between the last marked job and the selection", true},
+	{"*", "Invert marks on the current page", true},
+	{"Esc", "Clear marks", true},
+	{"r / c", "Retry / cancel selected job (or all marked jobs)", true},
+	{"d", "Delete selected job after typing its ID (or marked jobs)", true},
+	{"e", "Clone selected job, editing it in $EDITOR", true},
+	{"i", "Insert a new job from a form", true},
+	{"u", "Run now, postpone, reschedule, reprioritize or move", true},
+	{"R / C / D", "Retry / cancel / delete all jobs matching the filters", true},
+	{"q", "Quit", false},
+}
+
+var detailsKeyHelp = []keyHelp{
+	{"Enter/Esc", "Back to the job list", false},
+	{"E", "Browse the errors of each attempt", false},
+	{"↑/↓", "Scroll the details", false},
+	{"r / c", "Retry / cancel the job", true},
+	{"d", "Delete the job after typing its ID", true},
+	{"e", "Clone the job, editing it in $EDITOR", true},
+	{"u", "Run now, postpone, reschedule, reprioritize or move", true},
+	{"q", "Quit", false},
+}
+
+// showHelp lists every key binding of the job list or details, leaving out
+// the mutating ones in read-only mode
+func (m *MonitorApp) showHelp() {
+	m.lastActivePage, _ = m.ui.pages.GetFrontPage()
+	title, keys := "Job List", listKeyHelp
+	if m.lastActivePage == PageDetails {
+		title, keys = "Job Details", detailsKeyHelp
+	}
+
+	var text strings.Builder
+	for _, help := range keys {
+		if help.mutation && m.config.ReadOnly {
+			continue
+		}
+		text.WriteString(fmt.Sprintf("[#60A5FA]%-10s[white] %s\n", help.key, help.action))
+	}
+	if m.config.ReadOnly {
+		text.WriteString("\n[#F59E0B]Read-only mode: retry, cancel, delete, insert and update are disabled.[white]\n")
+	}
+
+	m.ui.helpView.SetTitle(fmt.Sprintf(" ❔ %s Keys (Esc: Close) ", title))
+	m.ui.helpView.SetText(text.String())
+	m.ui.helpView.ScrollToBeginning()
+	m.ui.pages.ShowPage(PageHelp)
+	m.ui.app.SetFocus(m.ui.helpView)
+}
+
+// closeHelp hides the help page and returns to the page it was opened from
+func (m *MonitorApp) closeHelp() {
+	m.ui.pages.HidePage(PageHelp)
+	if m.lastActivePage == PageDetails {
+		m.ui.pages.SwitchToPage(PageDetails)
+		m.ui.app.SetFocus(m.ui.jobDetails)
+	} else {
+		m.ui.pages.SwitchToPage(PageList)
+		m.ui.app.SetFocus(m.ui.jobList)
+	}
+}
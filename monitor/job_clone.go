@@ -0,0 +1,232 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
+)
+
+// clonedFromKey is the metadata key recording the job a clone was made from
+const clonedFromKey = "cloned_from_job_id"
+
+// jobDraft is the part of a job that can be edited before inserting a clone
+// of it. The kind is shown for reference and can't be changed.
+type jobDraft struct {
+	Kind        string          `json:"kind"`
+	Args        json.RawMessage `json:"args"`
+	Queue       string          `json:"queue"`
+	Priority    int             `json:"priority"`
+	MaxAttempts int             `json:"max_attempts"`
+	Tags        []string        `json:"tags"`
+	// ScheduledAt is null to make the clone available right away
+	ScheduledAt *time.Time `json:"scheduled_at"`
+}
+
+// handleJobClone clones the job selected in the list
+func (m *MonitorApp) handleJobClone() {
+	if !m.allowMutation() {
+		return
+	}
+	row, _ := m.ui.jobList.GetSelection()
+	if row > 0 {
+		m.cloneJob(m.ui.jobList.GetCell(row, 0).Text)
+	}
+}
+
+// handleJobCloneInDetails clones the job shown in the details view
+func (m *MonitorApp) handleJobCloneInDetails() {
+	if !m.allowMutation() {
+		return
+	}
+	if m.currentJobID != "" {
+		m.cloneJob(m.currentJobID)
+	}
+}
+
+// cloneJob fetches the job and opens a draft of its clone in the editor
+func (m *MonitorApp) cloneJob(jobID string) {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Invalid job ID: %v[white]", err))
+		return
+	}
+
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		job, err := cli.JobGet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			draft, err := json.MarshalIndent(jobDraft{
+				Kind:        job.Kind,
+				Args:        job.EncodedArgs,
+				Queue:       job.Queue,
+				Priority:    job.Priority,
+				MaxAttempts: job.MaxAttempts,
+				Tags:        job.Tags,
+			}, "", "  ")
+			if err != nil {
+				m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Failed to encode job %d: %v[white]", id, err))
+				return
+			}
+			m.editJobDraft(job, draft)
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Failed to get job: %v[white]", err))
+	})
+}
+
+// editJobDraft opens the draft in the editor, then asks to insert the clone,
+// or to edit the draft again if it is invalid
+func (m *MonitorApp) editJobDraft(source *rivertype.JobRow, draft []byte) {
+	edited, err := m.runEditor(fmt.Sprintf("rivertui-job-%d-*.json", source.ID), draft)
+	if err != nil {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: %v[white]", err))
+		return
+	}
+
+	params, err := parseJobDraft(source, edited)
+	if err != nil {
+		m.showConfirmationModal(
+			"Invalid Job",
+			fmt.Sprintf("The job can't be inserted: %s\n\n[#60A5FA]Y[white]: Edit again\n[#60A5FA]N[white]: Discard the changes", tview.Escape(err.Error())),
+			func() { m.editJobDraft(source, edited) },
+			func() {},
+		)
+		return
+	}
+
+	when := "right away"
+	if !params.ScheduledAt.IsZero() {
+		when = "at " + params.ScheduledAt.Local().Format(time.DateTime)
+	}
+	m.showConfirmationModal(
+		"Clone Job",
+		fmt.Sprintf("Insert a new %s job in queue %s, to run %s, as a clone of job %d?\n\n[#60A5FA]Y[white]: Yes, insert the job\n[#60A5FA]N[white]: No, discard it",
+			tview.Escape(params.Kind), tview.Escape(params.Queue), when, source.ID),
+		func() { m.insertJob(params, source.ID) },
+		func() {},
+	)
+}
+
+// parseJobDraft validates an edited draft of a clone of the source job
+func parseJobDraft(source *rivertype.JobRow, edited []byte) (client.JobInsertParams, error) {
+	var draft jobDraft
+	decoder := json.NewDecoder(bytes.NewReader(edited))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&draft); err != nil {
+		return client.JobInsertParams{}, fmt.Errorf("invalid JSON: %v", err)
+	}
+	if draft.Kind != source.Kind {
+		return client.JobInsertParams{}, fmt.Errorf("the kind can't be changed, it must stay %q", source.Kind)
+	}
+
+	// Keep the source's metadata, recording which job the clone was made from
+	fields := map[string]json.RawMessage{}
+	if len(source.Metadata) > 0 {
+		if err := json.Unmarshal(source.Metadata, &fields); err != nil {
+			return client.JobInsertParams{}, fmt.Errorf("invalid metadata in job %d: %v", source.ID, err)
+		}
+		if fields == nil {
+			fields = map[string]json.RawMessage{}
+		}
+	}
+	fields[clonedFromKey] = json.RawMessage(strconv.FormatInt(source.ID, 10))
+	metadata, err := json.Marshal(fields)
+	if err != nil {
+		return client.JobInsertParams{}, err
+	}
+	params := client.JobInsertParams{
+		Kind:        draft.Kind,
+		Args:        draft.Args,
+		Queue:       draft.Queue,
+		Priority:    draft.Priority,
+		MaxAttempts: draft.MaxAttempts,
+		Tags:        draft.Tags,
+		Metadata:    metadata,
+	}
+	if draft.ScheduledAt != nil {
+		params.ScheduledAt = *draft.ScheduledAt
+	}
+	if err := params.Validate(); err != nil {
+		return client.JobInsertParams{}, err
+	}
+	return params, nil
+}
+
+// insertJob inserts the clone of a job
+func (m *MonitorApp) insertJob(params client.JobInsertParams, sourceID int64) {
+	if !m.allowMutation() {
+		return
+	}
+
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		result, err := cli.JobInsert(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			m.ui.statusBar.SetText(fmt.Sprintf("[green]Inserted job %d, a clone of job %d[white]", result.Job.ID, sourceID))
+			m.loadFrontPage()
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error inserting job: %v[white]", err))
+	})
+}
+
+// runEditor suspends the UI to edit the content in $VISUAL or $EDITOR, vi
+// by default, and returns the edited content
+func (m *MonitorApp) runEditor(pattern string, content []byte) ([]byte, error) {
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the file to edit: %w", err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write the file to edit: %w", err)
+	}
+
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if strings.TrimSpace(editor) == "" {
+		editor = "vi"
+	}
+	// The editor may come with arguments, as in "code --wait"
+	args := append(strings.Fields(editor), file.Name())
+
+	var runErr error
+	m.ui.app.Suspend(func() {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		runErr = cmd.Run()
+	})
+	if runErr != nil {
+		return nil, fmt.Errorf("editor %s failed: %w", args[0], runErr)
+	}
+
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the edited file: %w", err)
+	}
+	return edited, nil
+}
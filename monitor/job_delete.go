@@ -0,0 +1,194 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
+)
+
+const deleteConfirmTitle = " 🗑  Delete Job (Enter: Delete, Esc: Cancel) "
+
+// typedDeletion is a deletion waiting for its confirmation to be typed: the
+// ID of a single job, or the number of jobs of a batch
+type typedDeletion struct {
+	title    string
+	label    string
+	expected string
+	hint     string
+	confirm  func()
+}
+
+// handleJobDelete asks to delete the job selected in the list
+func (m *MonitorApp) handleJobDelete() {
+	if !m.allowMutation() {
+		return
+	}
+	row, _ := m.ui.jobList.GetSelection()
+	if row > 0 {
+		m.confirmJobDelete(m.ui.jobList.GetCell(row, 0).Text)
+	}
+}
+
+// handleJobDeleteInDetails asks to delete the job shown in the details view
+func (m *MonitorApp) handleJobDeleteInDetails() {
+	if !m.allowMutation() {
+		return
+	}
+	if m.currentJobID != "" {
+		m.confirmJobDelete(m.currentJobID)
+	}
+}
+
+// confirmJobDelete fetches the job and, unless it is running, asks to type
+// its ID to delete it
+func (m *MonitorApp) confirmJobDelete(jobID string) {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Invalid job ID: %v[white]", err))
+		return
+	}
+
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		job, err := cli.JobGet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			if job.State == rivertype.JobStateRunning {
+				m.showJobRunningError(job.ID)
+				return
+			}
+			m.openDeleteConfirmation(job)
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Failed to get job: %v[white]", err))
+	})
+}
+
+// showJobRunningError explains why a running job cannot be deleted
+func (m *MonitorApp) showJobRunningError(id int64) {
+	m.ui.statusBar.SetText(fmt.Sprintf("[red]Job %d is running and cannot be deleted while a worker holds it. Cancel it with c and delete it once it has stopped.[white]", id))
+}
+
+// openDeleteConfirmation shows the job to delete and waits for its ID to be typed
+func (m *MonitorApp) openDeleteConfirmation(job *rivertype.JobRow) {
+	var message strings.Builder
+	message.WriteString(fmt.Sprintf("Permanently delete job [#EF4444]%d[white]?\n\n", job.ID))
+	message.WriteString(fmt.Sprintf("[#60A5FA]Kind:[white]  %s\n", tview.Escape(job.Kind)))
+	message.WriteString(fmt.Sprintf("[#60A5FA]Queue:[white] %s\n", tview.Escape(job.Queue)))
+	message.WriteString(fmt.Sprintf("[#60A5FA]State:[white] %s\n\n", job.State))
+	message.WriteString("This cannot be undone. Type the job ID to confirm.")
+
+	m.openTypedDeletion(message.String(), &typedDeletion{
+		title:    deleteConfirmTitle,
+		label:    "Job ID: ",
+		expected: strconv.FormatInt(job.ID, 10),
+		hint:     fmt.Sprintf("Type %d to delete the job", job.ID),
+		confirm:  func() { m.deleteJob(job.ID) },
+	})
+}
+
+// confirmBatchDelete asks to type the number of jobs before deleting them in a batch
+func (m *MonitorApp) confirmBatchDelete(description string, ids []int64, onConfirm func()) {
+	count := strconv.Itoa(len(ids))
+	m.openTypedDeletion(
+		fmt.Sprintf("Permanently delete [#EF4444]%d[white] %s?\n\nThis cannot be undone. Type the number of jobs to confirm.", len(ids), description),
+		&typedDeletion{
+			title:    " 🗑  Delete Jobs (Enter: Delete, Esc: Cancel) ",
+			label:    "Job count: ",
+			expected: count,
+			hint:     fmt.Sprintf("Type %s to delete the jobs", count),
+			confirm:  onConfirm,
+		})
+}
+
+// openTypedDeletion shows the deletion and waits for its confirmation to be typed
+func (m *MonitorApp) openTypedDeletion(message string, deletion *typedDeletion) {
+	m.lastActivePage, _ = m.ui.pages.GetFrontPage()
+	m.pendingDelete = deletion
+
+	m.ui.deleteConfirmText.SetText(message)
+	m.ui.deleteConfirmInput.SetLabel(deletion.label)
+	m.ui.deleteConfirmInput.SetText("")
+	m.setDeleteConfirmError("")
+	m.ui.pages.ShowPage(PageDeleteConfirmation)
+	m.ui.app.SetFocus(m.ui.deleteConfirmInput)
+}
+
+// setDeleteConfirmError shows why the typed confirmation was rejected, or restores the title when empty
+func (m *MonitorApp) setDeleteConfirmError(message string) {
+	if message == "" {
+		title := deleteConfirmTitle
+		if m.pendingDelete != nil {
+			title = m.pendingDelete.title
+		}
+		m.ui.deleteConfirmation.SetTitle(title)
+		return
+	}
+	m.ui.deleteConfirmation.SetTitle(fmt.Sprintf(" ✗ %s ", message))
+}
+
+// submitDeleteConfirmation runs the pending deletion if its confirmation was typed
+func (m *MonitorApp) submitDeleteConfirmation() {
+	deletion := m.pendingDelete
+	if deletion == nil {
+		m.closeDeleteConfirmation()
+		return
+	}
+	if strings.TrimSpace(m.ui.deleteConfirmInput.GetText()) != deletion.expected {
+		m.setDeleteConfirmError(deletion.hint)
+		return
+	}
+	m.closeDeleteConfirmation()
+	deletion.confirm()
+}
+
+// closeDeleteConfirmation hides the delete confirmation and returns to the previous page
+func (m *MonitorApp) closeDeleteConfirmation() {
+	m.pendingDelete = nil
+	m.ui.pages.HidePage(PageDeleteConfirmation)
+	if m.lastActivePage == PageDetails {
+		m.ui.pages.SwitchToPage(PageDetails)
+		m.ui.app.SetFocus(m.ui.jobDetails)
+	} else {
+		m.ui.pages.SwitchToPage(PageList)
+		m.ui.app.SetFocus(m.ui.jobList)
+	}
+}
+
+func (m *MonitorApp) deleteJob(id int64) {
+	if !m.allowMutation() {
+		return
+	}
+
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		if _, err := cli.JobDelete(ctx, id); err != nil {
+			return nil, err
+		}
+		return func() {
+			m.selection.Unmark(id)
+			// The details of a deleted job can't be shown anymore
+			if m.currentJobID == strconv.FormatInt(id, 10) {
+				m.currentJobID = ""
+				m.showJobList()
+			} else {
+				m.loadFrontPage()
+			}
+			m.ui.statusBar.SetText(fmt.Sprintf("[green]Job %d deleted[white]", id))
+		}, nil
+	}, func(err error) {
+		if errors.Is(err, client.ErrJobRunning) {
+			m.showJobRunningError(id)
+			return
+		}
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error deleting job: %v[white]", err))
+	})
+}
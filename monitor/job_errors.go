@@ -0,0 +1,199 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
+)
+
+// showJobErrors opens the errors pane for the job shown in the details view
+func (m *MonitorApp) showJobErrors() {
+	if m.currentJobID == "" {
+		return
+	}
+	m.errorsJob = nil
+	m.ui.errorList.Clear()
+	m.ui.errorList.SetTitle(fmt.Sprintf(" 🐞 Errors of Job %s ", tview.Escape(m.currentJobID)))
+	m.ui.errorTrace.SetTitle(" Trace ")
+	m.ui.errorTrace.SetText("Loading errors...")
+	m.ui.pages.SwitchToPage(PageErrors)
+	m.ui.app.SetFocus(m.ui.errorList)
+	m.setErrorsModeStatus()
+	m.loadJobErrors()
+}
+
+// closeJobErrors returns from the errors pane to the job details
+func (m *MonitorApp) closeJobErrors() {
+	m.errorsJob = nil
+	m.ui.pages.SwitchToPage(PageDetails)
+	m.ui.app.SetFocus(m.ui.jobDetails)
+	m.setDetailsModeStatus()
+	m.loadJobDetails()
+}
+
+func (m *MonitorApp) setErrorsModeStatus() {
+	m.setModeStatus("Errors",
+		[]string{"↑/↓ or n/p: Step attempts", "Tab: Scroll trace", "y: Copy error", "Y: Copy all errors", "Esc: Back to details"}, nil)
+}
+
+// loadJobErrors fetches the current job in the background to list its errors
+func (m *MonitorApp) loadJobErrors() {
+	id, err := strconv.ParseInt(m.currentJobID, 10, 64)
+	if err != nil {
+		m.fetcher.Cancel(viewFetch)
+		m.ui.errorTrace.SetText(fmt.Sprintf("Error: Invalid job ID: %v", err))
+		return
+	}
+
+	cli := m.client
+	m.loadOr(func(ctx context.Context) (func(), error) {
+		job, err := cli.JobGet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return func() { m.renderJobErrors(job) }, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Failed to get job: %v[white]", err))
+	})
+}
+
+// renderJobErrors lists the errors of each attempt of the job, keeping the
+// selected attempt across refreshes and selecting the latest one at first
+func (m *MonitorApp) renderJobErrors(job *rivertype.JobRow) {
+	selected := 0
+	if m.errorsJob != nil && m.errorsJob.ID == job.ID {
+		selected, _ = m.ui.errorList.GetSelection()
+	}
+	m.errorsJob = job
+
+	table := m.ui.errorList
+	table.Clear()
+	table.SetTitle(fmt.Sprintf(" 🐞 Errors of Job %d (%d of %d attempts failed) ↑/↓ ", job.ID, len(job.Errors), job.Attempt))
+	for col, header := range []string{"Attempt", "At", "Ago", "Error"} {
+		table.SetCell(0, col, tview.NewTableCell(header).
+			SetTextColor(ColorTitle).
+			SetSelectable(false))
+	}
+	for i, attemptError := range job.Errors {
+		row := i + 1
+		table.SetCell(row, 0, tview.NewTableCell(strconv.Itoa(attemptError.Attempt)).SetAlign(tview.AlignRight))
+		table.SetCell(row, 1, tview.NewTableCell(attemptError.At.Local().Format(time.DateTime)))
+		table.SetCell(row, 2, tview.NewTableCell(formatTimeAgo(attemptError.At)))
+		table.SetCell(row, 3, tview.NewTableCell(tview.Escape(firstLine(attemptError.Error))).
+			SetTextColor(ColorError).
+			SetExpansion(1))
+	}
+
+	if len(job.Errors) == 0 {
+		m.ui.errorTrace.SetTitle(" Trace ")
+		m.ui.errorTrace.SetText("This job has no errors.")
+		return
+	}
+	if selected < 1 || selected > len(job.Errors) {
+		selected = len(job.Errors)
+	}
+	table.Select(selected, 0)
+	m.renderAttemptError(selected)
+}
+
+// renderAttemptError shows the full message and trace of the error in the given row
+func (m *MonitorApp) renderAttemptError(row int) {
+	attemptError, ok := m.attemptErrorAt(row)
+	if !ok {
+		return
+	}
+	pattern := highlightPattern(m.filter.Highlights())
+
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("[#60A5FA]Attempt %d[white] at %s (%s ago)\n\n",
+		attemptError.Attempt, attemptError.At.Format(time.RFC3339), formatTimeAgo(attemptError.At)))
+	text.WriteString("[#EF4444]" + highlight(attemptError.Error, pattern) + "[white]\n\n")
+	if trace := strings.TrimRight(attemptError.Trace, "\n"); trace != "" {
+		text.WriteString("[#60A5FA]Trace[white]\n")
+		text.WriteString(highlight(trace, pattern) + "\n")
+	} else {
+		text.WriteString("[#64748B]No trace was recorded for this attempt[white]\n")
+	}
+
+	// Keep the scroll position while the same attempt refreshes
+	offset, _ := m.ui.errorTrace.GetScrollOffset()
+	title := fmt.Sprintf(" Attempt %d (%d/%d) ", attemptError.Attempt, row, len(m.errorsJob.Errors))
+	if m.ui.errorTrace.GetTitle() != title {
+		offset = 0
+		m.ui.errorTrace.SetTitle(title)
+	}
+	m.ui.errorTrace.SetText(text.String())
+	m.ui.errorTrace.ScrollTo(offset, 0)
+}
+
+// attemptErrorAt returns the error listed in the given row of the errors pane
+func (m *MonitorApp) attemptErrorAt(row int) (rivertype.AttemptError, bool) {
+	if m.errorsJob == nil || row < 1 || row > len(m.errorsJob.Errors) {
+		return rivertype.AttemptError{}, false
+	}
+	return m.errorsJob.Errors[row-1], true
+}
+
+// stepAttemptError selects the error of the next or previous attempt
+func (m *MonitorApp) stepAttemptError(delta int) {
+	if m.errorsJob == nil || len(m.errorsJob.Errors) == 0 {
+		return
+	}
+	row, _ := m.ui.errorList.GetSelection()
+	row = min(max(row+delta, 1), len(m.errorsJob.Errors))
+	m.ui.errorList.Select(row, 0)
+}
+
+// copySelectedError copies the message and trace of the selected attempt
+func (m *MonitorApp) copySelectedError() {
+	row, _ := m.ui.errorList.GetSelection()
+	attemptError, ok := m.attemptErrorAt(row)
+	if !ok {
+		return
+	}
+	m.copyToClipboard(formatAttemptError(attemptError), fmt.Sprintf("the error of attempt %d", attemptError.Attempt))
+}
+
+// copyAllErrors copies the messages and traces of every attempt
+func (m *MonitorApp) copyAllErrors() {
+	if m.errorsJob == nil || len(m.errorsJob.Errors) == 0 {
+		return
+	}
+	texts := make([]string, len(m.errorsJob.Errors))
+	for i, attemptError := range m.errorsJob.Errors {
+		texts[i] = formatAttemptError(attemptError)
+	}
+	m.copyToClipboard(strings.Join(texts, "\n\n"), fmt.Sprintf("the errors of %d attempts", len(texts)))
+}
+
+// formatAttemptError formats an error as plain text for the clipboard
+func formatAttemptError(attemptError rivertype.AttemptError) string {
+	text := fmt.Sprintf("Attempt %d at %s\n%s", attemptError.Attempt, attemptError.At.Format(time.RFC3339), attemptError.Error)
+	if trace := strings.TrimRight(attemptError.Trace, "\n"); trace != "" {
+		text += "\n\n" + trace
+	}
+	return text
+}
+
+// copyToClipboard has the terminal put the text in the system clipboard with
+// an OSC 52 sequence once the screen is drawn. This also works over SSH, but
+// some terminals ignore or need an option to allow it.
+func (m *MonitorApp) copyToClipboard(text, what string) {
+	m.clipboard = []byte(text)
+	m.ui.statusBar.SetText(fmt.Sprintf("[green]Copied %s to the clipboard[white] [#64748B](needs a terminal supporting OSC 52)[white]", what))
+}
+
+// firstLine returns the first non-empty line of the text
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
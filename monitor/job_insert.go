@@ -0,0 +1,153 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/rivo/tview"
+)
+
+const insertFormTitle = " ➕ Insert Job (Tab: Next field, Ctrl+S: Insert, Esc: Cancel) "
+
+// handleJobInsert opens an empty form to insert a job
+func (m *MonitorApp) handleJobInsert() {
+	if !m.allowMutation() {
+		return
+	}
+
+	fields := m.ui.insertFields
+	fields.kind.SetText("")
+	fields.args.SetText("{}", false)
+	fields.queue.SetText("")
+	fields.priority.SetText("")
+	fields.maxAttempts.SetText("")
+	fields.tags.SetText("")
+	fields.scheduleAt.SetText("")
+	fields.uniqueBy.SetText("")
+	m.ui.insertForm.SetFocus(0)
+	m.showInsertForm()
+	m.loadSearchCompletions()
+}
+
+// showInsertForm shows the insert form as it was last left
+func (m *MonitorApp) showInsertForm() {
+	m.setInsertFormError("")
+	m.ui.pages.ShowPage(PageInsertForm)
+	m.ui.app.SetFocus(m.ui.insertForm)
+}
+
+// closeInsertForm hides the insert form and returns to the job list
+func (m *MonitorApp) closeInsertForm() {
+	m.ui.pages.HidePage(PageInsertForm)
+	m.ui.pages.SwitchToPage(PageList)
+	m.ui.app.SetFocus(m.ui.jobList)
+}
+
+// setInsertFormError shows why the form can't be submitted, or restores the title when empty
+func (m *MonitorApp) setInsertFormError(message string) {
+	if message == "" {
+		m.ui.insertForm.SetTitle(insertFormTitle)
+		m.ui.insertForm.SetBorderColor(ColorTitle)
+		return
+	}
+	m.ui.insertForm.SetTitle(fmt.Sprintf(" ✗ %s ", tview.Escape(message)))
+	m.ui.insertForm.SetBorderColor(ColorError)
+}
+
+// submitInsertForm validates the form and asks to insert the job, going
+// back to the form to fix it when declined
+func (m *MonitorApp) submitInsertForm() {
+	params, err := m.ui.insertFields.params(time.Now())
+	if err != nil {
+		m.setInsertFormError(err.Error())
+		return
+	}
+	m.closeInsertForm()
+
+	queue := params.Queue
+	if queue == "" {
+		queue = "default"
+	}
+	when := "right away"
+	if !params.ScheduledAt.IsZero() {
+		when = "at " + params.ScheduledAt.Local().Format(time.DateTime)
+	}
+	unique := ""
+	if !params.Unique.IsZero() {
+		unique = " unless a matching job exists"
+	}
+	m.showConfirmationModal(
+		"Insert Job",
+		fmt.Sprintf("Insert a new %s job in queue %s, to run %s%s?\n\n[#60A5FA]Y[white]: Yes, insert the job\n[#60A5FA]N[white]: No, back to the form",
+			tview.Escape(params.Kind), tview.Escape(queue), when, unique),
+		func() { m.insertNewJob(params) },
+		m.showInsertForm,
+	)
+}
+
+// params reads the job to insert from the fields
+func (f *insertFormFields) params(now time.Time) (client.JobInsertParams, error) {
+	params := client.JobInsertParams{
+		Kind:  strings.TrimSpace(f.kind.GetText()),
+		Args:  json.RawMessage(strings.TrimSpace(f.args.GetText())),
+		Queue: strings.TrimSpace(f.queue.GetText()),
+	}
+
+	var err error
+	if text := f.priority.GetText(); text != "" {
+		if params.Priority, err = strconv.Atoi(text); err != nil {
+			return client.JobInsertParams{}, fmt.Errorf("invalid priority %q", text)
+		}
+	}
+	if text := f.maxAttempts.GetText(); text != "" {
+		if params.MaxAttempts, err = strconv.Atoi(text); err != nil {
+			return client.JobInsertParams{}, fmt.Errorf("invalid max attempts %q", text)
+		}
+	}
+	for _, tag := range strings.Split(f.tags.GetText(), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			params.Tags = append(params.Tags, tag)
+		}
+	}
+	if params.ScheduledAt, err = client.ParseScheduledAt(f.scheduleAt.GetText(), now); err != nil {
+		return client.JobInsertParams{}, err
+	}
+	if params.Unique, err = client.ParseUniqueBy(strings.Split(f.uniqueBy.GetText(), ",")); err != nil {
+		return client.JobInsertParams{}, err
+	}
+
+	if err := params.Validate(); err != nil {
+		return client.JobInsertParams{}, err
+	}
+	return params, nil
+}
+
+// insertNewJob inserts a job from the insert form
+func (m *MonitorApp) insertNewJob(params client.JobInsertParams) {
+	if !m.allowMutation() {
+		return
+	}
+
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		result, err := cli.JobInsert(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			if result.UniqueSkippedAsDuplicate {
+				m.ui.statusBar.SetText(fmt.Sprintf("[#F59E0B]Not inserted: job %d already matches the unique options[white]", result.Job.ID))
+			} else {
+				m.ui.statusBar.SetText(fmt.Sprintf("[green]Inserted %s job %d[white]", tview.Escape(result.Job.Kind), result.Job.ID))
+			}
+			m.loadFrontPage()
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error inserting job: %v[white]", err))
+	})
+}
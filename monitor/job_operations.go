@@ -3,32 +3,54 @@ package monitor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
 )
 
 // showJobDetails displays detailed information about a selected job
 func (m *MonitorApp) showJobDetails(jobID string) {
+	if jobID != m.currentJobID {
+		m.ui.jobDetails.SetText(fmt.Sprintf("Loading job %s...", tview.Escape(jobID)))
+	}
 	m.currentJobID = jobID // Store the current job ID
-	ctx := context.Background()
+	m.ui.app.SetFocus(m.ui.jobDetails)
+	m.loadJobDetails()
+}
 
+// loadJobDetails fetches the current job in the background
+func (m *MonitorApp) loadJobDetails() {
 	// Parse job ID
-	id, err := strconv.ParseInt(jobID, 10, 64)
+	id, err := strconv.ParseInt(m.currentJobID, 10, 64)
 	if err != nil {
+		m.fetcher.Cancel(viewFetch)
 		m.ui.jobDetails.SetText(fmt.Sprintf("Error: Invalid job ID: %v", err))
 		return
 	}
 
-	// Fetch job details
-	job, err := m.client.RiverClient.JobGet(ctx, id)
-	if err != nil {
-		m.ui.jobDetails.SetText(fmt.Sprintf("Error: Failed to get job: %v", err))
-		return
-	}
+	cli := m.client
+	m.loadOr(func(ctx context.Context) (func(), error) {
+		job, err := cli.JobGet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return func() { m.renderJobDetails(job) }, nil
+	}, func(err error) {
+		// Keep showing the last fetched details unless the job is gone
+		if errors.Is(err, rivertype.ErrNotFound) {
+			m.ui.jobDetails.SetText(fmt.Sprintf("Error: Failed to get job: %v", err))
+		}
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Failed to get job: %v[white]", err))
+	})
+}
+
+// renderJobDetails displays a fetched job
+func (m *MonitorApp) renderJobDetails(job *rivertype.JobRow) {
 
 	// Format job details with spacing, alignment, and color
 	var details strings.Builder
@@ -85,6 +107,9 @@ func (m *MonitorApp) showJobDetails(jobID string) {
 	details.WriteString(fmt.Sprintf("%s %s\n", pad("Attempted By:"), strings.Join(job.AttemptedBy, ",")))
 	details.WriteString("\n")
 
+	// Highlight the text the active search matched
+	pattern := highlightPattern(m.filter.Highlights())
+
 	// Add arguments if present
 	if len(job.EncodedArgs) > 0 {
 		details.WriteString("[#60A5FA]Arguments[white]\n") // Blue-400
@@ -92,11 +117,11 @@ func (m *MonitorApp) showJobDetails(jobID string) {
 		if err := json.Unmarshal(job.EncodedArgs, &prettyArgs); err == nil {
 			argsJSON, _ := json.MarshalIndent(prettyArgs, "", "  ")
 			for _, line := range strings.Split(string(argsJSON), "\n") {
-				details.WriteString("  " + line + "\n")
+				details.WriteString("  " + highlight(line, pattern) + "\n")
 			}
 		} else {
 			for _, line := range strings.Split(string(job.EncodedArgs), "\n") {
-				details.WriteString("  " + line + "\n")
+				details.WriteString("  " + highlight(line, pattern) + "\n")
 			}
 		}
 		details.WriteString("\n")
@@ -109,24 +134,23 @@ func (m *MonitorApp) showJobDetails(jobID string) {
 		if err := json.Unmarshal(job.Metadata, &prettyMeta); err == nil {
 			metaJSON, _ := json.MarshalIndent(prettyMeta, "", "  ")
 			for _, line := range strings.Split(string(metaJSON), "\n") {
-				details.WriteString("  " + line + "\n")
+				details.WriteString("  " + highlight(line, pattern) + "\n")
 			}
 		} else {
 			for _, line := range strings.Split(string(job.Metadata), "\n") {
-				details.WriteString("  " + line + "\n")
+				details.WriteString("  " + highlight(line, pattern) + "\n")
 			}
 		}
 		details.WriteString("\n")
 	}
 
-	// Add errors if present
+	// Add one line per failed attempt, the traces being in the errors pane
 	if len(job.Errors) > 0 {
-		details.WriteString("[#60A5FA]Errors[#EF4444]\n") // Blue-400 for header, Red-500 for content
-		errorsJSON, _ := json.MarshalIndent(job.Errors, "", "  ")
-		for _, line := range strings.Split(string(errorsJSON), "\n") {
-			details.WriteString("  " + line + "\n")
+		details.WriteString(fmt.Sprintf("[#60A5FA]Errors[white] [#64748B](E: browse the %d attempts with their traces)[white]\n", len(job.Errors))) // Blue-400, Slate-500
+		for _, attemptError := range job.Errors {
+			details.WriteString(fmt.Sprintf("  #%-3d %6s ago  [#EF4444]%s[white]\n", attemptError.Attempt, formatTimeAgo(attemptError.At), highlight(firstLine(attemptError.Error), pattern)))
 		}
-		details.WriteString("[white]\n")
+		details.WriteString("\n")
 	}
 
 	// Add tags if present
@@ -140,13 +164,15 @@ func (m *MonitorApp) showJobDetails(jobID string) {
 	}
 
 	m.ui.jobDetails.SetText(details.String())
-	m.ui.app.SetFocus(m.ui.jobDetails)
 
 	// Update status bar with retry shortcut
-	m.ui.statusBar.SetText("[#60A5FA]Mode:[white] Details | Enter/Esc: Back to list | r: Retry job | c: Cancel job | q: Quit") // Blue-400
+	m.setDetailsModeStatus()
 }
 
 func (m *MonitorApp) handleJobRetry() {
+	if !m.allowMutation() {
+		return
+	}
 	row, _ := m.ui.jobList.GetSelection()
 	if row > 0 {
 		jobID := m.ui.jobList.GetCell(row, 0).Text
@@ -155,6 +181,9 @@ func (m *MonitorApp) handleJobRetry() {
 }
 
 func (m *MonitorApp) handleJobCancel() {
+	if !m.allowMutation() {
+		return
+	}
 	row, _ := m.ui.jobList.GetSelection()
 	if row > 0 {
 		jobID := m.ui.jobList.GetCell(row, 0).Text
@@ -163,12 +192,18 @@ func (m *MonitorApp) handleJobCancel() {
 }
 
 func (m *MonitorApp) handleJobRetryInDetails() {
+	if !m.allowMutation() {
+		return
+	}
 	if m.currentJobID != "" {
 		m.showJobRetryConfirmation(m.currentJobID)
 	}
 }
 
 func (m *MonitorApp) handleJobCancelInDetails() {
+	if !m.allowMutation() {
+		return
+	}
 	if m.currentJobID != "" {
 		m.showJobCancelConfirmation(m.currentJobID)
 	}
@@ -193,39 +228,49 @@ func (m *MonitorApp) showJobCancelConfirmation(jobID string) {
 }
 
 func (m *MonitorApp) retryJob(jobID string) {
+	if !m.allowMutation() {
+		return
+	}
 	id, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Invalid job ID: %v[white]", err))
 		return
 	}
 
-	ctx := context.Background()
-	_, err = m.client.RiverClient.JobRetry(ctx, id)
-	if err != nil {
-		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error retrying job: %v[white]", err))
-	} else {
-		m.ui.statusBar.SetText("[green]Job retry initiated[white]")
-		if m.currentJobID == jobID {
-			m.showJobDetails(m.currentJobID)
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		if _, err := cli.JobRetry(ctx, id); err != nil {
+			return nil, err
 		}
-	}
+		return func() {
+			m.ui.statusBar.SetText("[green]Job retry initiated[white]")
+			m.loadFrontPage()
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error retrying job: %v[white]", err))
+	})
 }
 
 func (m *MonitorApp) cancelJob(jobID string) {
+	if !m.allowMutation() {
+		return
+	}
 	id, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Invalid job ID: %v[white]", err))
 		return
 	}
 
-	ctx := context.Background()
-	_, err = m.client.RiverClient.JobCancel(ctx, id)
-	if err != nil {
-		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error cancelling job: %v[white]", err))
-	} else {
-		m.ui.statusBar.SetText("[green]Job cancellation initiated[white]")
-		if m.currentJobID == jobID {
-			m.showJobDetails(m.currentJobID)
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		if _, err := cli.JobCancel(ctx, id); err != nil {
+			return nil, err
 		}
-	}
+		return func() {
+			m.ui.statusBar.SetText("[green]Job cancellation initiated[white]")
+			m.loadFrontPage()
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error cancelling job: %v[white]", err))
+	})
 }
@@ -0,0 +1,274 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
+)
+
+// updateTarget is what the update form applies to: a single job, or the marked jobs
+type updateTarget struct {
+	job *rivertype.JobRow
+	ids []int64
+}
+
+// title describes the target in the form and its confirmation
+func (t *updateTarget) title() string {
+	if t.job != nil {
+		return fmt.Sprintf("Job %d", t.job.ID)
+	}
+	return fmt.Sprintf("%d Marked Jobs", len(t.ids))
+}
+
+// handleJobUpdate opens the update form for the marked jobs, or the job selected in the list
+func (m *MonitorApp) handleJobUpdate() {
+	if !m.allowMutation() {
+		return
+	}
+	if m.selection.Count() > 0 {
+		if m.batchCancel != nil {
+			m.ui.statusBar.SetText("[yellow]A bulk operation is already running[white]")
+			return
+		}
+		m.openUpdateForm(&updateTarget{ids: m.selection.IDs()})
+		return
+	}
+	row, _ := m.ui.jobList.GetSelection()
+	if row > 0 {
+		m.confirmJobUpdate(m.ui.jobList.GetCell(row, 0).Text)
+	}
+}
+
+// handleJobUpdateInDetails opens the update form for the job shown in the details view
+func (m *MonitorApp) handleJobUpdateInDetails() {
+	if !m.allowMutation() {
+		return
+	}
+	if m.currentJobID != "" {
+		m.confirmJobUpdate(m.currentJobID)
+	}
+}
+
+// confirmJobUpdate fetches the job and, if it is still waiting to run, opens the update form
+func (m *MonitorApp) confirmJobUpdate(jobID string) {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Invalid job ID: %v[white]", err))
+		return
+	}
+
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		job, err := cli.JobGet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			if !slices.Contains(client.PendingStates, job.State) {
+				m.showJobNotPendingError(job.ID, job.State)
+				return
+			}
+			m.openUpdateForm(&updateTarget{job: job})
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: Failed to get job: %v[white]", err))
+	})
+}
+
+// showJobNotPendingError explains why a job can't be rescheduled or moved
+func (m *MonitorApp) showJobNotPendingError(id int64, state rivertype.JobState) {
+	m.ui.statusBar.SetText(fmt.Sprintf("[red]Job %d is %s. Only available, scheduled and retryable jobs can be rescheduled, reprioritized or moved.[white]", id, state))
+}
+
+// openUpdateForm fills the update form with the job's priority and queue, or
+// leaves it empty for marked jobs, and shows it
+func (m *MonitorApp) openUpdateForm(target *updateTarget) {
+	m.lastActivePage, _ = m.ui.pages.GetFrontPage()
+	m.pendingUpdate = target
+
+	fields := m.ui.updateFields
+	fields.runAt.SetText("")
+	fields.priority.SetText("")
+	fields.queue.SetText("")
+	if target.job != nil {
+		fields.priority.SetText(strconv.Itoa(target.job.Priority))
+		fields.queue.SetText(target.job.Queue)
+	}
+	m.ui.updateForm.SetFocus(0)
+	m.showUpdateForm()
+	m.loadSearchCompletions()
+}
+
+// showUpdateForm shows the update form as it was last left
+func (m *MonitorApp) showUpdateForm() {
+	m.setUpdateFormError("")
+	m.ui.pages.ShowPage(PageUpdateForm)
+	m.ui.app.SetFocus(m.ui.updateForm)
+}
+
+// hideUpdateForm hides the update form and returns to the page it was opened from
+func (m *MonitorApp) hideUpdateForm() {
+	m.ui.pages.HidePage(PageUpdateForm)
+	if m.lastActivePage == PageDetails {
+		m.ui.pages.SwitchToPage(PageDetails)
+		m.ui.app.SetFocus(m.ui.jobDetails)
+	} else {
+		m.ui.pages.SwitchToPage(PageList)
+		m.ui.app.SetFocus(m.ui.jobList)
+	}
+}
+
+// closeUpdateForm drops the pending update and hides the form
+func (m *MonitorApp) closeUpdateForm() {
+	m.pendingUpdate = nil
+	m.hideUpdateForm()
+}
+
+// setUpdateFormError shows why the form can't be submitted, or restores the title when empty
+func (m *MonitorApp) setUpdateFormError(message string) {
+	if message == "" {
+		title := "Update"
+		if m.pendingUpdate != nil {
+			title = "Update " + m.pendingUpdate.title()
+		}
+		m.ui.updateForm.SetTitle(fmt.Sprintf(" ⏱  %s (Ctrl+S: Update, Esc: Cancel) ", title))
+		m.ui.updateForm.SetBorderColor(ColorTitle)
+		return
+	}
+	m.ui.updateForm.SetTitle(fmt.Sprintf(" ✗ %s ", tview.Escape(message)))
+	m.ui.updateForm.SetBorderColor(ColorError)
+}
+
+// runUpdateFormNow submits the form with the jobs set to run right away
+func (m *MonitorApp) runUpdateFormNow() {
+	m.ui.updateFields.runAt.SetText("now")
+	m.submitUpdateForm()
+}
+
+// submitUpdateForm validates the form and asks to apply the changes, going
+// back to the form when declined
+func (m *MonitorApp) submitUpdateForm() {
+	target := m.pendingUpdate
+	if target == nil {
+		m.closeUpdateForm()
+		return
+	}
+	params, err := m.ui.updateFields.params(target.job, time.Now())
+	if err != nil {
+		m.setUpdateFormError(err.Error())
+		return
+	}
+	m.hideUpdateForm()
+
+	m.showConfirmationModal(
+		"Update "+target.title(),
+		fmt.Sprintf("Update %s: %s?\n\n[#60A5FA]Y[white]: Yes, update\n[#60A5FA]N[white]: No, back to the form",
+			strings.ToLower(target.title()), describeJobUpdate(params)),
+		func() {
+			m.pendingUpdate = nil
+			if target.job != nil {
+				m.updateJob(target.job.ID, params)
+				return
+			}
+			m.clearMarks()
+			m.runBatch(m.updateAction(params), target.ids)
+		},
+		m.showUpdateForm,
+	)
+}
+
+// params reads the changes from the fields, leaving out the priority and
+// queue the job already has
+func (f *updateFormFields) params(job *rivertype.JobRow, now time.Time) (client.JobUpdateParams, error) {
+	var params client.JobUpdateParams
+
+	runAt := strings.TrimSpace(f.runAt.GetText())
+	if delay, ok := strings.CutPrefix(runAt, "+"); ok {
+		postpone, err := time.ParseDuration(delay)
+		if err != nil || postpone <= 0 {
+			return client.JobUpdateParams{}, fmt.Errorf("invalid postpone duration %q", delay)
+		}
+		params.Postpone = postpone
+	} else {
+		scheduledAt, err := client.ParseScheduledAt(runAt, now)
+		if err != nil {
+			return client.JobUpdateParams{}, err
+		}
+		params.ScheduledAt = scheduledAt
+	}
+
+	if text := f.priority.GetText(); text != "" {
+		priority, err := strconv.Atoi(text)
+		if err != nil {
+			return client.JobUpdateParams{}, fmt.Errorf("invalid priority %q", text)
+		}
+		if job == nil || priority != job.Priority {
+			params.Priority = priority
+		}
+	}
+	if queue := strings.TrimSpace(f.queue.GetText()); queue != "" && (job == nil || queue != job.Queue) {
+		params.Queue = queue
+	}
+
+	if err := params.Validate(); err != nil {
+		return client.JobUpdateParams{}, err
+	}
+	return params, nil
+}
+
+// describeJobUpdate lists the changes for the confirmation, e.g. "postpone by 2h and move to queue mail"
+func describeJobUpdate(params client.JobUpdateParams) string {
+	var changes []string
+	switch {
+	case params.Postpone > 0:
+		changes = append(changes, "postpone by "+params.Postpone.String())
+	case !params.ScheduledAt.IsZero() && !params.ScheduledAt.After(time.Now()):
+		changes = append(changes, "run right away")
+	case !params.ScheduledAt.IsZero():
+		changes = append(changes, "run at "+params.ScheduledAt.Local().Format(time.DateTime))
+	}
+	if params.Priority != 0 {
+		changes = append(changes, fmt.Sprintf("set priority %d", params.Priority))
+	}
+	if params.Queue != "" {
+		changes = append(changes, "move to queue "+tview.Escape(params.Queue))
+	}
+
+	if len(changes) > 1 {
+		return strings.Join(changes[:len(changes)-1], ", ") + " and " + changes[len(changes)-1]
+	}
+	return strings.Join(changes, "")
+}
+
+// updateJob applies the changes to a single job
+func (m *MonitorApp) updateJob(id int64, params client.JobUpdateParams) {
+	if !m.allowMutation() {
+		return
+	}
+
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		job, err := cli.JobUpdate(ctx, id, params)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			m.ui.statusBar.SetText(fmt.Sprintf("[green]Job %d updated: %s in queue %s, priority %d[white]", job.ID, job.State, tview.Escape(job.Queue), job.Priority))
+			m.loadFrontPage()
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error updating job: %v[white]", err))
+		// The job may have started meanwhile, so show its current state
+		if errors.Is(err, client.ErrJobNotPending) {
+			m.loadFrontPage()
+		}
+	})
+}
@@ -5,14 +5,25 @@ import (
 	"strings"
 
 	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
 )
 
 func (m *MonitorApp) setupKeyBindings() {
 	m.setupJobListKeyBindings()
 	m.setupKindFilterKeyBindings()
+	m.setupQueueFilterKeyBindings()
 	m.setupConfirmationKeyBindings()
+	m.setupDeleteConfirmationKeyBindings()
+	m.setupInsertFormKeyBindings()
+	m.setupUpdateFormKeyBindings()
 	m.setupJobDetailsKeyBindings()
+	m.setupJobErrorsKeyBindings()
 	m.setupQueueKeyBindings()
+	m.setupProgressKeyBindings()
+	m.setupProfileKeyBindings()
+	m.setupDashboardKeyBindings()
+	m.setupChartsKeyBindings()
+	m.setupHelpKeyBindings()
 }
 
 func (m *MonitorApp) setupJobListKeyBindings() {
@@ -30,6 +41,20 @@ func (m *MonitorApp) setupJobListKeyBindings() {
 		case tcell.KeyCtrlQ:
 			m.showQueues()
 			return nil
+		case tcell.KeyCtrlP:
+			m.showProfiles()
+			return nil
+		case tcell.KeyCtrlD:
+			m.showDashboard()
+			return nil
+		case tcell.KeyCtrlT:
+			m.showCharts()
+			return nil
+		case tcell.KeyEsc:
+			if m.selection.Count() > 0 {
+				m.clearMarks()
+				return nil
+			}
 		case tcell.KeyRune:
 			if event.Rune() == 'q' {
 				m.ui.app.Stop()
@@ -39,12 +64,68 @@ func (m *MonitorApp) setupJobListKeyBindings() {
 				m.openKindFilter()
 				return nil
 			}
+			if event.Rune() == '?' {
+				m.showHelp()
+				return nil
+			}
+			if event.Rune() == ' ' {
+				m.toggleMark()
+				return nil
+			}
+			if event.Rune() == 'V' {
+				m.markRange()
+				return nil
+			}
+			if event.Rune() == '*' {
+				m.invertMarks()
+				return nil
+			}
 			if event.Rune() == 'r' {
-				m.handleJobRetry()
+				if m.selection.Count() > 0 {
+					m.handleMarkedAction(m.retryAction())
+				} else {
+					m.handleJobRetry()
+				}
 				return nil
 			}
 			if event.Rune() == 'c' {
-				m.handleJobCancel()
+				if m.selection.Count() > 0 {
+					m.handleMarkedAction(m.cancelAction())
+				} else {
+					m.handleJobCancel()
+				}
+				return nil
+			}
+			if event.Rune() == 'd' {
+				if m.selection.Count() > 0 {
+					m.handleMarkedAction(m.deleteAction())
+				} else {
+					m.handleJobDelete()
+				}
+				return nil
+			}
+			if event.Rune() == 'e' {
+				m.handleJobClone()
+				return nil
+			}
+			if event.Rune() == 'i' {
+				m.handleJobInsert()
+				return nil
+			}
+			if event.Rune() == 'u' {
+				m.handleJobUpdate()
+				return nil
+			}
+			if event.Rune() == 'R' {
+				m.handleBulkAction(m.retryAction())
+				return nil
+			}
+			if event.Rune() == 'C' {
+				m.handleBulkAction(m.cancelAction())
+				return nil
+			}
+			if event.Rune() == 'D' {
+				m.handleBulkAction(m.deleteAction())
 				return nil
 			}
 			if event.Rune() == 'n' {
@@ -55,9 +136,29 @@ func (m *MonitorApp) setupJobListKeyBindings() {
 				m.previousPage()
 				return nil
 			}
+			if event.Rune() == 's' {
+				m.nextSortColumn()
+				return nil
+			}
+			if event.Rune() == 'S' {
+				m.toggleSortDirection()
+				return nil
+			}
+			if event.Rune() == 'Q' {
+				m.openQueueFilter()
+				return nil
+			}
+			if event.Rune() == '+' {
+				m.toggleStateMode()
+				return nil
+			}
 			if event.Rune() >= '0' && event.Rune() <= '7' {
 				stateNum := int(event.Rune() - '0')
-				m.setStateFilter(stateNum)
+				if m.stateToggle || event.Modifiers()&tcell.ModAlt != 0 {
+					m.toggleStateFilter(stateNum)
+				} else {
+					m.setStateFilter(stateNum)
+				}
 				return nil
 			}
 		}
@@ -66,18 +167,31 @@ func (m *MonitorApp) setupJobListKeyBindings() {
 }
 
 func (m *MonitorApp) setupKindFilterKeyBindings() {
+	m.ui.kindFilterInput.SetChangedFunc(func(string) {
+		m.clearSearchError()
+	})
+	m.ui.kindFilterInput.SetAutocompleteFunc(func(text string) []string {
+		return m.completer.Complete(text)
+	})
+	m.ui.kindFilterInput.SetAutocompletedFunc(func(text string, index, source int) bool {
+		// Keep the typed text while browsing, and complete it on Enter or Tab
+		if source == tview.AutocompletedNavigate {
+			return false
+		}
+		m.ui.kindFilterInput.SetText(m.completer.Accept(index))
+		return true
+	})
 	m.ui.kindFilterInput.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// Let the autocomplete drop-down handle Enter and Esc while it is open
+		if m.completer.shown && (event.Key() == tcell.KeyEnter || event.Key() == tcell.KeyEsc) {
+			if event.Key() == tcell.KeyEsc {
+				m.completer.Dismiss()
+			}
+			return event
+		}
 		switch event.Key() {
 		case tcell.KeyEnter:
 			text := strings.TrimSpace(m.ui.kindFilterInput.GetText())
-			if text == "" {
-				m.filter.SetKindFilter(nil)
-				m.pagination.Reset()
-				m.scrollToBeginning = true
-				m.updateFilterStatusBar()
-				m.closeKindFilter()
-				return nil
-			}
 
 			// Check if the input is a valid integer (job ID)
 			if _, err := strconv.ParseInt(text, 10, 64); err == nil {
@@ -89,18 +203,21 @@ func (m *MonitorApp) setupKindFilterKeyBindings() {
 				return nil
 			}
 
-			// Input is not an integer, treat as kind filter
-			m.filter.SetKindFilter([]string{text})
+			// Otherwise parse it as a search expression, keeping the input open on errors
+			search, err := ParseSearch(text)
+			if err != nil {
+				m.showSearchError(err)
+				return nil
+			}
+			m.filter.ApplySearch(search)
 			m.pagination.Reset()
 			m.scrollToBeginning = true
 			m.updateFilterStatusBar()
 			m.closeKindFilter()
+			m.warnUnknownNames(search)
+			m.loadJobList()
 			return nil
 		case tcell.KeyEsc:
-			m.filter.SetKindFilter(nil)
-			m.pagination.Reset()
-			m.scrollToBeginning = true
-			m.updateFilterStatusBar()
 			m.closeKindFilter()
 			return nil
 		}
@@ -108,26 +225,42 @@ func (m *MonitorApp) setupKindFilterKeyBindings() {
 	})
 }
 
+func (m *MonitorApp) setupQueueFilterKeyBindings() {
+	m.ui.queueFilterList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			m.applyQueueFilter()
+			return nil
+		case tcell.KeyEsc:
+			m.closeQueueFilter()
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == ' ' {
+				m.toggleQueueFilterRow()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
 func (m *MonitorApp) setupConfirmationKeyBindings() {
 	m.ui.confirmationModal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyRune:
 			switch event.Rune() {
 			case 'Y', 'y':
-				m.modalState.ExecuteYes()
-				m.closeConfirmationModal()
+				m.resolveConfirmationModal(true)
 				return nil
 			case 'N', 'n':
-				m.modalState.ExecuteNo()
-				m.closeConfirmationModal()
+				m.resolveConfirmationModal(false)
 				return nil
 			case 'q':
 				m.ui.app.Stop()
 				return nil
 			}
 		case tcell.KeyEsc:
-			m.modalState.ExecuteNo()
-			m.closeConfirmationModal()
+			m.resolveConfirmationModal(false)
 			return nil
 		}
 		return event
@@ -139,9 +272,7 @@ func (m *MonitorApp) setupJobDetailsKeyBindings() {
 		switch event.Key() {
 		case tcell.KeyEnter, tcell.KeyEsc:
 			m.currentJobID = ""
-			m.ui.pages.SwitchToPage(PageList)
-			m.ui.app.SetFocus(m.ui.jobList)
-			m.setListModeStatus()
+			m.showJobList()
 			return nil
 		case tcell.KeyRune:
 			if event.Rune() == 'q' {
@@ -156,18 +287,151 @@ func (m *MonitorApp) setupJobDetailsKeyBindings() {
 				m.handleJobCancelInDetails()
 				return nil
 			}
+			if event.Rune() == 'd' {
+				m.handleJobDeleteInDetails()
+				return nil
+			}
+			if event.Rune() == 'e' {
+				m.handleJobCloneInDetails()
+				return nil
+			}
+			if event.Rune() == 'u' {
+				m.handleJobUpdateInDetails()
+				return nil
+			}
+			if event.Rune() == 'E' {
+				m.showJobErrors()
+				return nil
+			}
+			if event.Rune() == '?' {
+				m.showHelp()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+func (m *MonitorApp) setupJobErrorsKeyBindings() {
+	m.ui.errorList.SetSelectionChangedFunc(func(row, column int) {
+		m.renderAttemptError(row)
+	})
+	// Keys shared by the list of attempts and the trace
+	handle := func(event *tcell.EventKey) bool {
+		switch event.Rune() {
+		case 'n':
+			m.stepAttemptError(1)
+		case 'p':
+			m.stepAttemptError(-1)
+		case 'y':
+			m.copySelectedError()
+		case 'Y':
+			m.copyAllErrors()
+		case 'q':
+			m.ui.app.Stop()
+		default:
+			return false
+		}
+		return true
+	}
+
+	m.ui.errorList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			m.closeJobErrors()
+			return nil
+		case tcell.KeyEnter, tcell.KeyTab:
+			m.ui.app.SetFocus(m.ui.errorTrace)
+			m.ui.errorTrace.SetBorderColor(ColorTitle)
+			return nil
+		case tcell.KeyRune:
+			if handle(event) {
+				return nil
+			}
+		}
+		return event
+	})
+	m.ui.errorTrace.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc, tcell.KeyEnter, tcell.KeyTab, tcell.KeyBacktab:
+			m.ui.app.SetFocus(m.ui.errorList)
+			m.ui.errorTrace.SetBorderColor(ColorBorder)
+			return nil
+		case tcell.KeyRune:
+			if handle(event) {
+				return nil
+			}
 		}
 		return event
 	})
 }
 
+func (m *MonitorApp) setupDeleteConfirmationKeyBindings() {
+	m.ui.deleteConfirmInput.SetChangedFunc(func(string) {
+		m.setDeleteConfirmError("")
+	})
+	m.ui.deleteConfirmInput.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			m.submitDeleteConfirmation()
+			return nil
+		case tcell.KeyEsc:
+			m.closeDeleteConfirmation()
+			return nil
+		}
+		return event
+	})
+}
+
+func (m *MonitorApp) setupInsertFormKeyBindings() {
+	fields := m.ui.insertFields
+	fields.kind.SetAutocompleteFunc(func(text string) []string {
+		return completeNames(text, m.completer.names.Kinds)
+	})
+	fields.queue.SetAutocompleteFunc(func(text string) []string {
+		return completeNames(text, m.completer.names.Queues)
+	})
+
+	m.ui.insertForm.AddButton("Insert", m.submitInsertForm)
+	m.ui.insertForm.AddButton("Cancel", m.closeInsertForm)
+	m.ui.insertForm.SetCancelFunc(m.closeInsertForm)
+	m.ui.insertForm.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyCtrlS {
+			m.submitInsertForm()
+			return nil
+		}
+		m.setInsertFormError("")
+		return event
+	})
+}
+
+func (m *MonitorApp) setupUpdateFormKeyBindings() {
+	m.ui.updateFields.queue.SetAutocompleteFunc(func(text string) []string {
+		return completeNames(text, m.completer.names.Queues)
+	})
+
+	m.ui.updateForm.AddButton("Update", m.submitUpdateForm)
+	m.ui.updateForm.AddButton("Run now", m.runUpdateFormNow)
+	m.ui.updateForm.AddButton("Cancel", m.closeUpdateForm)
+	m.ui.updateForm.SetCancelFunc(m.closeUpdateForm)
+	m.ui.updateForm.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyCtrlS {
+			m.submitUpdateForm()
+			return nil
+		}
+		m.setUpdateFormError("")
+		return event
+	})
+}
+
 func (m *MonitorApp) setupQueueKeyBindings() {
 	m.ui.queueList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
+		case tcell.KeyEnter:
+			m.showQueueJobs()
+			return nil
 		case tcell.KeyEsc:
-			m.ui.pages.SwitchToPage(PageList)
-			m.ui.app.SetFocus(m.ui.jobList)
-			m.setListModeStatus()
+			m.showJobList()
 			return nil
 		case tcell.KeyRune:
 			if event.Rune() == 'q' {
@@ -186,3 +450,98 @@ func (m *MonitorApp) setupQueueKeyBindings() {
 		return event
 	})
 }
+
+func (m *MonitorApp) setupProgressKeyBindings() {
+	m.ui.progressModal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			m.abortBatch()
+			return nil
+		}
+		return event
+	})
+}
+
+func (m *MonitorApp) setupProfileKeyBindings() {
+	m.ui.profileList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			m.handleProfileSwitch()
+			return nil
+		case tcell.KeyEsc:
+			m.closeProfiles()
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == 'q' {
+				m.ui.app.Stop()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+func (m *MonitorApp) setupDashboardKeyBindings() {
+	m.ui.dashboard.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			m.showJobList()
+			return nil
+		case tcell.KeyCtrlQ:
+			m.showQueues()
+			return nil
+		case tcell.KeyCtrlT:
+			m.showCharts()
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == 'q' {
+				m.ui.app.Stop()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+func (m *MonitorApp) setupChartsKeyBindings() {
+	m.ui.charts.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			m.showJobList()
+			return nil
+		case tcell.KeyCtrlD:
+			m.showDashboard()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'w':
+				m.charts.NextWindow()
+				m.loadCharts()
+				return nil
+			case 'm':
+				m.charts.NextMetric()
+				m.renderCharts()
+				return nil
+			case 'q':
+				m.ui.app.Stop()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+func (m *MonitorApp) setupHelpKeyBindings() {
+	m.ui.helpView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc, tcell.KeyEnter:
+			m.closeHelp()
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == '?' || event.Rune() == 'q' {
+				m.closeHelp()
+				return nil
+			}
+		}
+		return event
+	})
+}
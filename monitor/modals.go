@@ -2,22 +2,38 @@ package monitor
 
 import (
 	"fmt"
+
+	"github.com/rivo/tview"
 )
 
 // openKindFilter opens the kind filter input modal
 func (m *MonitorApp) openKindFilter() {
-	// Clear previous input and show current filter if any
-	if len(m.filter.kindFilter) > 0 {
-		m.ui.kindFilterInput.SetText(m.filter.kindFilter[0])
-	} else {
-		m.ui.kindFilterInput.SetText("")
-	}
+	// Show the current filter as an expression so it can be edited
+	m.ui.kindFilterInput.SetText(m.filter.Expression())
+	m.clearSearchError()
+	m.completer.Dismiss()
+	m.loadSearchCompletions()
 	m.ui.pages.ShowPage(PageKindFilter)
 	m.ui.app.SetFocus(m.ui.kindFilterInput)
 }
 
+// showSearchError shows a search expression error in the input frame
+func (m *MonitorApp) showSearchError(err error) {
+	m.ui.kindFilterInput.SetTitle(fmt.Sprintf(" ✗ %s ", tview.Escape(err.Error())))
+	m.ui.kindFilterInput.SetBorderColor(ColorError)
+	m.ui.kindFilterInput.SetTitleColor(ColorError)
+}
+
+// clearSearchError restores the input frame after a search expression error
+func (m *MonitorApp) clearSearchError() {
+	m.ui.kindFilterInput.SetTitle(searchInputTitle)
+	m.ui.kindFilterInput.SetBorderColor(ColorTitle)
+	m.ui.kindFilterInput.SetTitleColor(ColorTitle)
+}
+
 // closeKindFilter closes the kind filter input modal and returns to the list
 func (m *MonitorApp) closeKindFilter() {
+	m.fetcher.Cancel(completionFetch)
 	m.ui.pages.HidePage(PageKindFilter)
 	m.ui.pages.SwitchToPage(PageList)
 	m.ui.app.SetFocus(m.ui.jobList)
@@ -40,6 +56,18 @@ func (m *MonitorApp) showConfirmationModal(title, message string, onYes, onNo fu
 	m.ui.app.SetFocus(m.ui.confirmationModal)
 }
 
+// resolveConfirmationModal closes the confirmation modal, then runs the chosen callback
+func (m *MonitorApp) resolveConfirmationModal(confirmed bool) {
+	// Copy the callbacks since closing the modal clears them
+	state := *m.modalState
+	m.closeConfirmationModal()
+	if confirmed {
+		state.ExecuteYes()
+	} else {
+		state.ExecuteNo()
+	}
+}
+
 // closeConfirmationModal closes the confirmation modal and returns to the previous page
 func (m *MonitorApp) closeConfirmationModal() {
 	// Clear the callbacks
@@ -2,38 +2,84 @@ package monitor
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
 func (m *MonitorApp) setupUI() {
 	// Create layouts
+	statusRow := tview.NewFlex().
+		AddItem(m.ui.statusBar, 0, 1, false).
+		AddItem(m.ui.fetchIndicator, 26, 0, false)
+
 	listFlex := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(m.ui.jobList, 0, 1, true).
 		AddItem(m.ui.filterStatusBar, 1, 0, false).
-		AddItem(m.ui.statusBar, 1, 0, false)
+		AddItem(statusRow, 1, 0, false)
 
 	detailsFlex := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(m.ui.jobDetails, 0, 1, true).
-		AddItem(m.ui.statusBar, 1, 0, false)
+		AddItem(statusRow, 1, 0, false)
+
+	errorsFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(m.ui.errorList, 0, 1, true).
+		AddItem(m.ui.errorTrace, 0, 2, false).
+		AddItem(statusRow, 1, 0, false)
 
 	queueFlex := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(m.ui.queueList, 0, 1, true).
-		AddItem(m.ui.statusBar, 1, 0, false)
+		AddItem(statusRow, 1, 0, false)
+
+	dashboardFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(m.ui.dashboard, 0, 1, true).
+		AddItem(statusRow, 1, 0, false)
+
+	chartsFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(m.ui.charts, 0, 1, true).
+		AddItem(statusRow, 1, 0, false)
+
+	profileFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(m.ui.profileList, 0, 1, true).
+		AddItem(statusRow, 1, 0, false)
 
-	kindFilterModal := createCenteredModal(m.ui.kindFilterInput, 60, 3)
+	kindFilterModal := createCenteredModal(m.ui.kindFilterInput, 100, 3)
+	queueFilterModal := createCenteredModal(m.ui.queueFilterList, 64, 16)
 	confirmationModalLayout := createCenteredModal(m.ui.confirmationModal, 60, 8)
+	progressModalLayout := createCenteredModal(m.ui.progressModal, 60, 7)
+	deleteConfirmationLayout := createCenteredModal(m.ui.deleteConfirmation, 64, 11)
+	insertFormLayout := createCenteredModal(m.ui.insertForm, 80, 21)
+	updateFormLayout := createCenteredModal(m.ui.updateForm, 72, 11)
+	helpLayout := createCenteredModal(m.ui.helpView, 80, 26)
 
 	// Add pages
 	m.ui.pages.AddPage(PageList, listFlex, true, true)
 	m.ui.pages.AddPage(PageDetails, detailsFlex, true, false)
+	m.ui.pages.AddPage(PageErrors, errorsFlex, true, false)
 	m.ui.pages.AddPage(PageQueues, queueFlex, true, false)
+	m.ui.pages.AddPage(PageProfiles, profileFlex, true, false)
+	m.ui.pages.AddPage(PageDashboard, dashboardFlex, true, false)
+	m.ui.pages.AddPage(PageCharts, chartsFlex, true, false)
 	m.ui.pages.AddPage(PageKindFilter, kindFilterModal, true, false)
+	m.ui.pages.AddPage(PageQueueFilter, queueFilterModal, true, false)
 	m.ui.pages.AddPage(PageConfirmation, confirmationModalLayout, true, false)
+	m.ui.pages.AddPage(PageProgress, progressModalLayout, true, false)
+	m.ui.pages.AddPage(PageDeleteConfirmation, deleteConfirmationLayout, true, false)
+	m.ui.pages.AddPage(PageInsertForm, insertFormLayout, true, false)
+	m.ui.pages.AddPage(PageUpdateForm, updateFormLayout, true, false)
+	m.ui.pages.AddPage(PageHelp, helpLayout, true, false)
 
-	// Initialize filter status bar and help text
+	// Hand copied text to the terminal once the screen is drawn
+	m.ui.app.SetAfterDrawFunc(func(screen tcell.Screen) {
+		if m.clipboard != nil {
+			screen.SetClipboard(m.clipboard)
+			m.clipboard = nil
+		}
+	})
+
+	// Initialize filter status bar, titles and help text
 	m.updateFilterStatusBar()
+	m.updateFrameTitles()
 
 	// Check if we should start with job details
 	if m.initialJobID > 0 {
@@ -45,38 +91,11 @@ func (m *MonitorApp) setupUI() {
 	}
 }
 
-// StartRefreshLoop begins the background refresh loop
-func (m *MonitorApp) StartRefreshLoop() {
-	go func() {
-		for {
-			m.ui.app.QueueUpdateDraw(func() {
-				pageName, _ := m.ui.pages.GetFrontPage()
-
-				switch pageName {
-				case PageQueues:
-					// Refresh queue list when on queue page
-					if err := m.updateQueueList(); err != nil {
-						m.ui.statusBar.SetText(fmt.Sprintf("Error: %v", err))
-					}
-				case PageDetails:
-					// Refresh job details when on details page and have a current job ID
-					if m.currentJobID != "" {
-						m.showJobDetails(m.currentJobID)
-					}
-				default:
-					// Default to refreshing job list for other pages
-					if err := m.updateJobList(); err != nil {
-						m.ui.statusBar.SetText(fmt.Sprintf("Error: %v", err))
-					}
-				}
-			})
-			time.Sleep(1 * time.Second)
-		}
-	}()
-}
-
 // Run starts the monitor application
 func (m *MonitorApp) Run() error {
+	m.startListener()
 	m.StartRefreshLoop()
-	return m.ui.app.SetRoot(m.ui.pages, true).EnableMouse(false).Run()
+	err := m.ui.app.SetRoot(m.ui.pages, true).EnableMouse(false).Run()
+	m.stopListener()
+	return err
 }
@@ -0,0 +1,446 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/almottier/rivertui/config"
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/almottier/rivertui/internal/memory"
+	"github.com/gdamore/tcell/v2"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// testMonitor runs the monitor over an in-memory source on a simulated
+// screen, so tests can type keys and read back what was drawn
+type testMonitor struct {
+	t      *testing.T
+	m      *MonitorApp
+	source *memory.Source
+	screen tcell.SimulationScreen
+}
+
+func newTestMonitor(t *testing.T, source *memory.Source, cfg *config.Config) *testMonitor {
+	t.Helper()
+	m := NewMonitorApp(source, cfg, 0, nil, nil, nil)
+	// Profile switches may rebuild the widgets but keep the application
+	app := m.ui.app
+
+	// SetScreen initializes the screen, which resets its size
+	screen := tcell.NewSimulationScreen("UTF-8")
+	app.SetScreen(screen)
+	screen.SetSize(140, 30)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := app.SetRoot(m.ui.pages, true).Run(); err != nil {
+			t.Error(err)
+		}
+	}()
+	t.Cleanup(func() {
+		app.Stop()
+		<-done
+	})
+	app.QueueUpdateDraw(m.loadFrontPage)
+	return &testMonitor{t: t, m: m, source: source, screen: screen}
+}
+
+// text returns the drawn screen, one line per row. Cells are read one by one
+// since GetContents returns the buffer the UI goroutine keeps drawing into.
+func (tm *testMonitor) text() string {
+	width, height := tm.screen.Size()
+	var text strings.Builder
+	for y := range height {
+		for x := range width {
+			r, _, _, _ := tm.screen.GetContent(x, y)
+			text.WriteRune(r)
+		}
+		text.WriteRune('\n')
+	}
+	return text.String()
+}
+
+// waitFor waits until the condition holds, failing the test after a few seconds
+func (tm *testMonitor) waitFor(description string, condition func() bool) {
+	tm.t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			tm.t.Fatalf("timed out waiting for %s, screen:\n%s", description, tm.text())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitForText waits until the screen shows the text
+func (tm *testMonitor) waitForText(text string) {
+	tm.t.Helper()
+	tm.waitFor("screen to show "+text, func() bool { return strings.Contains(tm.text(), text) })
+}
+
+// waitForNoText waits until the screen no longer shows the text
+func (tm *testMonitor) waitForNoText(text string) {
+	tm.t.Helper()
+	tm.waitFor("screen to hide "+text, func() bool { return !strings.Contains(tm.text(), text) })
+}
+
+func (tm *testMonitor) typeText(text string) {
+	for _, r := range text {
+		tm.screen.InjectKey(tcell.KeyRune, r, tcell.ModNone)
+	}
+}
+
+func (tm *testMonitor) press(key tcell.Key) {
+	tm.screen.InjectKey(key, 0, tcell.ModNone)
+}
+
+// jobState returns the state of the job, or an empty state once it is deleted
+func (tm *testMonitor) jobState(id int64) rivertype.JobState {
+	job, err := tm.source.JobGet(context.Background(), id)
+	if errors.Is(err, rivertype.ErrNotFound) {
+		return ""
+	}
+	if err != nil {
+		tm.t.Fatal(err)
+	}
+	return job.State
+}
+
+// waitForState waits until the job reaches the state
+func (tm *testMonitor) waitForState(id int64, state rivertype.JobState) {
+	tm.t.Helper()
+	tm.waitFor("job to be "+string(state), func() bool { return tm.jobState(id) == state })
+}
+
+func TestJobList(t *testing.T) {
+	source := memory.NewSource()
+	source.AddJob(&rivertype.JobRow{Kind: "email.send", State: rivertype.JobStateCompleted})
+	source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateDiscarded})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("email.send")
+	tm.waitForText("report.build")
+
+	// 3 shows the discarded jobs only
+	tm.typeText("3")
+	tm.waitForNoText("email.send")
+	tm.waitForText("report.build")
+
+	tm.typeText("0")
+	tm.waitForText("email.send")
+}
+
+func TestStateToggle(t *testing.T) {
+	source := memory.NewSource()
+	source.AddJob(&rivertype.JobRow{Kind: "email.send", State: rivertype.JobStateCompleted})
+	source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateDiscarded})
+	source.AddJob(&rivertype.JobRow{Kind: "cleanup.run"})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("cleanup.run")
+
+	// In toggle mode, the state keys add states to the filter
+	tm.typeText("+13")
+	tm.waitForNoText("cleanup.run")
+	tm.waitForText("email.send")
+	tm.waitForText("report.build")
+
+	// and remove them again
+	tm.typeText("1")
+	tm.waitForNoText("email.send")
+	tm.waitForText("report.build")
+}
+
+func TestJobDetails(t *testing.T) {
+	source := memory.NewSource()
+	source.AddJob(&rivertype.JobRow{Kind: "report.build", EncodedArgs: []byte(`{"customer":"Acme Corp"}`)})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.press(tcell.KeyEnter)
+	tm.waitForText("Acme Corp")
+
+	tm.press(tcell.KeyEscape)
+	tm.waitForNoText("Acme Corp")
+	tm.waitForText("report.build")
+}
+
+func TestJobErrors(t *testing.T) {
+	source := memory.NewSource()
+	at := time.Now().Add(-time.Hour)
+	source.AddJob(&rivertype.JobRow{
+		Kind:    "report.build",
+		State:   rivertype.JobStateRetryable,
+		Attempt: 2,
+		Errors: []rivertype.AttemptError{
+			{Attempt: 1, At: at, Error: "connection refused", Trace: "goroutine 1 [running]:\nmain.first()"},
+			{Attempt: 2, At: at.Add(time.Minute), Error: "rate limited", Trace: "goroutine 1 [running]:\nmain.second()"},
+		},
+	})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.press(tcell.KeyEnter)
+	tm.waitForText("rate limited")
+	tm.typeText("E")
+
+	// The latest attempt is selected first
+	tm.waitForText("2 of 2 attempts failed")
+	tm.waitForText("main.second()")
+
+	tm.typeText("p")
+	tm.waitForText("main.first()")
+	tm.waitForNoText("main.second()")
+}
+
+func TestJobRetry(t *testing.T) {
+	source := memory.NewSource()
+	job := source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateDiscarded})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.typeText("r")
+	tm.waitForText("Retry Job")
+
+	// N leaves the job alone
+	tm.typeText("n")
+	tm.waitForNoText("Retry Job")
+	if state := tm.jobState(job.ID); state != rivertype.JobStateDiscarded {
+		t.Fatalf("declined retry left the job %s", state)
+	}
+
+	tm.typeText("r")
+	tm.waitForText("Retry Job")
+	tm.typeText("y")
+	tm.waitForState(job.ID, rivertype.JobStateAvailable)
+	tm.waitForText("available")
+}
+
+func TestJobCancel(t *testing.T) {
+	source := memory.NewSource()
+	job := source.AddJob(&rivertype.JobRow{Kind: "report.build"})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.typeText("c")
+	tm.waitForText("Cancel Job")
+	tm.typeText("y")
+	tm.waitForState(job.ID, rivertype.JobStateCancelled)
+	tm.waitForText("cancelled")
+}
+
+func TestJobDeleteRequiresTypedID(t *testing.T) {
+	source := memory.NewSource()
+	job := source.AddJob(&rivertype.JobRow{ID: 42, Kind: "report.build", State: rivertype.JobStateDiscarded})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.typeText("d")
+	tm.waitForText("Type the job ID to confirm")
+
+	tm.typeText("4")
+	tm.press(tcell.KeyEnter)
+	tm.waitForText("Type 42 to delete the job")
+	if state := tm.jobState(job.ID); state == "" {
+		t.Fatal("job deleted with the wrong ID typed")
+	}
+
+	tm.typeText("2")
+	tm.press(tcell.KeyEnter)
+	tm.waitForState(job.ID, "")
+	tm.waitForNoText("report.build")
+}
+
+func TestRunningJobDeleteRefused(t *testing.T) {
+	source := memory.NewSource()
+	job := source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateRunning})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.typeText("d")
+	tm.waitForText("is running and cannot be deleted")
+	if strings.Contains(tm.text(), "Type the job ID to confirm") {
+		t.Fatal("delete confirmation shown for a running job")
+	}
+	if state := tm.jobState(job.ID); state != rivertype.JobStateRunning {
+		t.Fatalf("running job is %q", state)
+	}
+}
+
+func TestDeleteMarkedJobs(t *testing.T) {
+	source := memory.NewSource()
+	kept := source.AddJob(&rivertype.JobRow{Kind: "report.build"})
+	first := source.AddJob(&rivertype.JobRow{Kind: "email.send", State: rivertype.JobStateDiscarded})
+	second := source.AddJob(&rivertype.JobRow{Kind: "email.send", State: rivertype.JobStateCompleted})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	// Jobs are listed newest first, and Space moves down after marking
+	tm.waitForText("report.build")
+	tm.typeText("  ")
+	tm.waitForText("2 marked")
+	tm.typeText("d")
+	tm.waitForText("Type the number of jobs to confirm")
+	tm.typeText("2")
+	tm.press(tcell.KeyEnter)
+	tm.waitForState(first.ID, "")
+	tm.waitForState(second.ID, "")
+	tm.waitForNoText("email.send")
+	if state := tm.jobState(kept.ID); state != rivertype.JobStateAvailable {
+		t.Errorf("unmarked job is %q", state)
+	}
+}
+
+func TestBulkDeleteRequiresTypedCount(t *testing.T) {
+	source := memory.NewSource()
+	for range 30 {
+		source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateDiscarded})
+	}
+	kept := source.AddJob(&rivertype.JobRow{Kind: "email.send"})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.typeText("3")
+	tm.waitForNoText("email.send")
+	tm.typeText("D")
+	tm.waitForText("Type the number of jobs to confirm")
+
+	tm.typeText("3")
+	tm.press(tcell.KeyEnter)
+	tm.waitForText("Type 30 to delete the jobs")
+
+	tm.typeText("0")
+	tm.press(tcell.KeyEnter)
+	tm.waitFor("discarded jobs to be deleted", func() bool {
+		jobs, err := source.JobList(context.Background(), client.JobListQuery{States: []rivertype.JobState{rivertype.JobStateDiscarded}})
+		return err == nil && len(jobs) == 0
+	})
+	if state := tm.jobState(kept.ID); state != rivertype.JobStateAvailable {
+		t.Errorf("job outside the filter is %q", state)
+	}
+}
+
+func TestBulkDeleteSkipsRunningJobs(t *testing.T) {
+	source := memory.NewSource()
+	source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateDiscarded})
+	source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateCompleted})
+	running := source.AddJob(&rivertype.JobRow{Kind: "email.send", State: rivertype.JobStateRunning})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.typeText("D")
+	tm.waitForText("Type the number of jobs to confirm")
+	tm.typeText("3")
+	tm.press(tcell.KeyEnter)
+	tm.waitForNoText("report.build")
+	if state := tm.jobState(running.ID); state != rivertype.JobStateRunning {
+		t.Errorf("running job is %q", state)
+	}
+}
+
+func TestQueuePauseResume(t *testing.T) {
+	source := memory.NewSource()
+	source.AddQueue("mail")
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.press(tcell.KeyCtrlQ)
+	tm.waitForText("ACTIVE")
+
+	tm.typeText("p")
+	tm.waitForText("pause queue 'mail'")
+	tm.typeText("y")
+	tm.waitFor("queue to pause", func() bool { return tm.queuePaused("mail") })
+	// PAUSED is also a column header, so look for the state to change instead
+	tm.waitForNoText("ACTIVE")
+
+	tm.typeText("r")
+	tm.waitForText("resume queue 'mail'")
+	tm.typeText("y")
+	tm.waitFor("queue to resume", func() bool { return !tm.queuePaused("mail") })
+	tm.waitForText("ACTIVE")
+}
+
+func (tm *testMonitor) queuePaused(name string) bool {
+	queues, err := tm.source.QueueList(context.Background(), 100)
+	if err != nil {
+		tm.t.Fatal(err)
+	}
+	for _, queue := range queues {
+		if queue.Name == name {
+			return queue.PausedAt != nil
+		}
+	}
+	tm.t.Fatalf("queue %s not found", name)
+	return false
+}
+
+func TestReadOnlyRefusesMutations(t *testing.T) {
+	source := memory.NewSource()
+	job := source.AddJob(&rivertype.JobRow{Kind: "report.build", State: rivertype.JobStateDiscarded})
+	tm := newTestMonitor(t, source, &config.Config{ReadOnly: true})
+
+	tm.waitForText("report.build")
+	tm.typeText("r")
+	tm.waitForText("Read-only mode")
+	if strings.Contains(tm.text(), "Retry Job") {
+		t.Fatal("retry confirmation shown in read-only mode")
+	}
+	if state := tm.jobState(job.ID); state != rivertype.JobStateDiscarded {
+		t.Fatalf("read-only retry left the job %s", state)
+	}
+}
+
+func TestHelp(t *testing.T) {
+	source := memory.NewSource()
+	source.AddJob(&rivertype.JobRow{Kind: "report.build"})
+	tm := newTestMonitor(t, source, &config.Config{})
+
+	tm.waitForText("report.build")
+	tm.typeText("?")
+	tm.waitForText("Job List Keys")
+	tm.waitForText("Retry / cancel / delete all jobs matching the filters")
+
+	tm.press(tcell.KeyEscape)
+	tm.waitForNoText("Job List Keys")
+}
+
+func TestProfileSwitchAppliesThemeAndKeepsRefreshFlag(t *testing.T) {
+	t.Cleanup(resetTheme)
+	source := memory.NewSource()
+	source.AddJob(&rivertype.JobRow{Kind: "email.send"})
+	staging := memory.NewSource()
+	staging.AddJob(&rivertype.JobRow{Kind: "report.build"})
+
+	cfg := &config.Config{Profile: "local", Profiles: map[string]config.Profile{
+		"local":   {DatabaseURL: "postgres://localhost/app"},
+		"staging": {DatabaseURL: "postgres://staging/app", Refresh: 5 * time.Second, Theme: map[string]string{"border": "#FF0000"}},
+	}}
+	config.UpdateConfigFromFlags(cfg, "", 2*time.Second, false)
+	tm := newTestMonitor(t, source, cfg)
+	app := tm.m.ui.app
+	tm.m.connect = func(ctx context.Context, cfg *config.Config) (DataSource, error) {
+		return staging, nil
+	}
+
+	tm.waitForText("email.send")
+	tm.press(tcell.KeyCtrlP)
+	tm.waitForText("staging")
+	tm.press(tcell.KeyDown)
+	tm.press(tcell.KeyEnter)
+	tm.waitForText("report.build")
+
+	// The job list border is drawn in the theme's border color
+	tm.waitFor("the staging theme", func() bool {
+		_, _, style, _ := tm.screen.GetContent(0, 0)
+		fg, _, _ := style.Decompose()
+		return fg == tcell.NewRGBColor(255, 0, 0)
+	})
+	interval := make(chan time.Duration, 1)
+	app.QueueUpdate(func() { interval <- tm.m.config.RefreshInterval })
+	if got := <-interval; got != 2*time.Second {
+		t.Errorf("refresh interval is %s after the switch, want the flag's 2s", got)
+	}
+}
@@ -0,0 +1,178 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/almottier/rivertui/config"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rivo/tview"
+)
+
+// connectionName returns the active profile name, or host/database when no profile is used
+func (m *MonitorApp) connectionName() string {
+	if m.config.Profile != "" {
+		return m.config.Profile
+	}
+	if cfg, err := pgconn.ParseConfig(m.config.Database.URL); err == nil {
+		return fmt.Sprintf("%s/%s", cfg.Host, cfg.Database)
+	}
+	return ""
+}
+
+// connectionSuffix returns the connection name formatted for frame titles
+func (m *MonitorApp) connectionSuffix() string {
+	suffix := ""
+	if name := m.connectionName(); name != "" {
+		suffix = " · " + tview.Escape(name)
+	}
+	if m.config.ReadOnly {
+		suffix += " (read-only)"
+	}
+	return suffix
+}
+
+// updateFrameTitles refreshes every frame title that shows the active connection
+func (m *MonitorApp) updateFrameTitles() {
+	m.updateJobListTitle()
+	m.ui.queueList.SetTitle(fmt.Sprintf(" 🔀 Queues%s ", m.connectionSuffix()))
+	m.ui.jobDetails.SetTitle(fmt.Sprintf(" 📋 Job Details%s (Enter/Esc to return) ", m.connectionSuffix()))
+	m.ui.dashboard.SetTitle(fmt.Sprintf(" 📊 Dashboard%s ", m.connectionSuffix()))
+	m.ui.charts.SetTitle(fmt.Sprintf(" 📈 Charts%s ", m.connectionSuffix()))
+}
+
+// showProfiles switches to the profile switcher page
+func (m *MonitorApp) showProfiles() {
+	names := m.config.ProfileNames()
+	if len(names) == 0 {
+		m.ui.statusBar.SetText(fmt.Sprintf("[yellow]No profiles configured, add them to %s[white]", config.DefaultPath()))
+		return
+	}
+
+	m.ui.profileList.Clear()
+	m.setProfileTableHeaders()
+	for i, name := range names {
+		m.addProfileToTable(i+1, name, m.config.Profiles[name])
+		if name == m.config.Profile {
+			m.ui.profileList.Select(i+1, 0)
+		}
+	}
+
+	m.fetcher.Cancel(viewFetch)
+	m.ui.pages.SwitchToPage(PageProfiles)
+	m.ui.app.SetFocus(m.ui.profileList)
+	m.setProfileModeStatus()
+}
+
+func (m *MonitorApp) setProfileTableHeaders() {
+	headers := []string{"", "PROFILE", "DATABASE", "REFRESH"}
+	for i, header := range headers {
+		m.ui.profileList.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(ColorTitle).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false).
+				SetExpansion(1))
+	}
+}
+
+func (m *MonitorApp) addProfileToTable(row int, name string, profile config.Profile) {
+	active := ""
+	if name == m.config.Profile {
+		active = "●"
+	}
+	m.ui.profileList.SetCell(row, 0, tview.NewTableCell(active).SetTextColor(ColorSuccess))
+	m.ui.profileList.SetCell(row, 1, tview.NewTableCell(name).SetTextColor(ColorPrimary).SetReference(name))
+	m.ui.profileList.SetCell(row, 2, tview.NewTableCell(config.RedactURL(profile.DatabaseURL)).SetTextColor(ColorSecondary))
+
+	refresh := ""
+	if profile.Refresh > 0 {
+		refresh = profile.Refresh.String()
+	}
+	m.ui.profileList.SetCell(row, 3, tview.NewTableCell(refresh).SetTextColor(ColorTertiary))
+}
+
+func (m *MonitorApp) setProfileModeStatus() {
+	m.setModeStatus("Profiles", []string{"Enter: Connect", "Esc: Back to jobs"}, nil)
+}
+
+// handleProfileSwitch connects to the profile on the selected row
+func (m *MonitorApp) handleProfileSwitch() {
+	row, _ := m.ui.profileList.GetSelection()
+	if row <= 0 {
+		return
+	}
+	name, _ := m.ui.profileList.GetCell(row, 1).GetReference().(string)
+	if name == "" || name == m.config.Profile {
+		m.closeProfiles()
+		return
+	}
+	if m.batchCancel != nil {
+		m.ui.statusBar.SetText("[yellow]Wait for the bulk operation to finish before switching connections[white]")
+		return
+	}
+
+	newConfig := *m.config
+	if err := newConfig.ApplyProfile(name); err != nil {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: %v[white]", err))
+		return
+	}
+
+	m.ui.statusBar.SetText(fmt.Sprintf("[#60A5FA]Connecting to %s...[white]", tview.Escape(name)))
+	go func() {
+		cli, err := m.connect(context.Background(), &newConfig)
+		m.ui.app.QueueUpdateDraw(func() {
+			if err != nil {
+				m.ui.statusBar.SetText(fmt.Sprintf("[red]Error connecting to %s: %v[white]", tview.Escape(name), err))
+				return
+			}
+			m.switchClient(cli, &newConfig)
+			m.closeProfiles()
+			m.ui.statusBar.SetText(fmt.Sprintf("[green]Connected to %s[white]", tview.Escape(name)))
+		})
+	}()
+}
+
+// switchClient replaces the active connection, closing the previous one and resetting view state
+func (m *MonitorApp) switchClient(cli DataSource, cfg *config.Config) {
+	previous := m.client
+	m.client = cli
+	previousTheme := m.config.Theme
+	m.config = cfg
+	if !maps.Equal(previousTheme, cfg.Theme) {
+		m.applyProfileTheme(cfg.Theme)
+	}
+	previous.Close()
+	m.startListener()
+
+	m.pagination.Reset()
+	m.filter = NewJobFilter()
+	m.sort = JobSort{}
+	m.applyConfigFilters()
+	m.selection.Clear()
+	m.charts = newChartState()
+	m.completer = &SearchCompleter{}
+	m.currentJobID = ""
+	m.scrollToBeginning = true
+	m.updateFilterStatusBar()
+	m.updateFrameTitles()
+}
+
+// applyProfileTheme replaces the colors of the previous profile's theme with
+// the theme's. Widgets take their colors when created, so they are rebuilt.
+func (m *MonitorApp) applyProfileTheme(theme map[string]string) {
+	resetTheme()
+	applyTheme(theme)
+
+	m.initialJobID = 0
+	m.ui = newUIComponents(m.ui.app)
+	m.setupUI()
+	m.setupKeyBindings()
+	m.ui.app.SetRoot(m.ui.pages, true)
+}
+
+// closeProfiles returns from the profile switcher to the job list
+func (m *MonitorApp) closeProfiles() {
+	m.showJobList()
+}
@@ -0,0 +1,142 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
+)
+
+// queueFilterFetch is the fetch key of the queues listed in the queue filter
+const queueFilterFetch = "queueFilter"
+
+// QueuePicker tracks the queues listed and checked in the queue filter modal
+type QueuePicker struct {
+	names    []string
+	paused   map[string]bool
+	selected map[string]bool
+}
+
+func newQueuePicker(selected []string) *QueuePicker {
+	picker := &QueuePicker{
+		paused:   make(map[string]bool),
+		selected: make(map[string]bool),
+	}
+	for _, name := range selected {
+		picker.selected[name] = true
+	}
+	picker.names = picker.Selected()
+	return picker
+}
+
+// SetQueues lists the queues, keeping checked queues that no longer exist so they can be unchecked
+func (qp *QueuePicker) SetQueues(queues []*rivertype.Queue) {
+	qp.names = qp.names[:0]
+	for _, queue := range queues {
+		qp.names = append(qp.names, queue.Name)
+		qp.paused[queue.Name] = queue.PausedAt != nil
+	}
+	for name := range qp.selected {
+		if !slices.Contains(qp.names, name) {
+			qp.names = append(qp.names, name)
+		}
+	}
+	slices.Sort(qp.names)
+}
+
+// Toggle checks or unchecks a queue
+func (qp *QueuePicker) Toggle(name string) {
+	if qp.selected[name] {
+		delete(qp.selected, name)
+	} else {
+		qp.selected[name] = true
+	}
+}
+
+// Selected returns the checked queues in name order
+func (qp *QueuePicker) Selected() []string {
+	names := make([]string, 0, len(qp.selected))
+	for name := range qp.selected {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// openQueueFilter shows the queue filter modal with the active queue filter checked
+func (m *MonitorApp) openQueueFilter() {
+	m.queuePicker = newQueuePicker(m.filter.queueFilter)
+	m.renderQueueFilter()
+	m.ui.queueFilterList.Select(0, 0)
+	m.ui.pages.ShowPage(PageQueueFilter)
+	m.ui.app.SetFocus(m.ui.queueFilterList)
+
+	cli := m.client
+	m.fetcher.Fetch(queueFilterFetch, func(ctx context.Context) (func(), error) {
+		queues, err := cli.QueueList(ctx, maxQueues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list queues: %w", err)
+		}
+		return func() {
+			if m.queuePicker != nil {
+				m.queuePicker.SetQueues(queues)
+				m.renderQueueFilter()
+			}
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error: %v[white]", err))
+	})
+}
+
+// renderQueueFilter lists the queues with their check marks, keeping the selected row
+func (m *MonitorApp) renderQueueFilter() {
+	row, _ := m.ui.queueFilterList.GetSelection()
+	m.ui.queueFilterList.Clear()
+	for i, name := range m.queuePicker.names {
+		mark := "[ ]"
+		if m.queuePicker.selected[name] {
+			mark = "[x]"
+		}
+		m.ui.queueFilterList.SetCell(i, 0, tview.NewTableCell(tview.Escape(mark)).SetTextColor(ColorTitle))
+		m.ui.queueFilterList.SetCell(i, 1, tview.NewTableCell(tview.Escape(name)).
+			SetTextColor(ColorPrimary).
+			SetExpansion(1))
+		if m.queuePicker.paused[name] {
+			m.ui.queueFilterList.SetCell(i, 2, tview.NewTableCell("PAUSED").SetTextColor(ColorCancelled))
+		}
+	}
+	if len(m.queuePicker.names) > 0 {
+		m.ui.queueFilterList.Select(min(row, len(m.queuePicker.names)-1), 0)
+	}
+}
+
+// toggleQueueFilterRow checks or unchecks the queue on the selected row
+func (m *MonitorApp) toggleQueueFilterRow() {
+	row, _ := m.ui.queueFilterList.GetSelection()
+	if row < 0 || row >= len(m.queuePicker.names) {
+		return
+	}
+	m.queuePicker.Toggle(m.queuePicker.names[row])
+	m.renderQueueFilter()
+}
+
+// applyQueueFilter filters the job list on the checked queues, or on all queues when none are checked
+func (m *MonitorApp) applyQueueFilter() {
+	m.filter.SetQueueFilter(m.queuePicker.Selected())
+	m.pagination.Reset()
+	m.scrollToBeginning = true
+	m.updateFilterStatusBar()
+	m.closeQueueFilter()
+	m.loadJobList()
+}
+
+// closeQueueFilter closes the queue filter modal and returns to the list
+func (m *MonitorApp) closeQueueFilter() {
+	m.fetcher.Cancel(queueFilterFetch)
+	m.queuePicker = nil
+	m.ui.pages.HidePage(PageQueueFilter)
+	m.ui.pages.SwitchToPage(PageList)
+	m.ui.app.SetFocus(m.ui.jobList)
+}
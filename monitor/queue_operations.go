@@ -4,35 +4,39 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/rivo/tview"
 )
 
-// updateQueueList refreshes the queue list table
-func (m *MonitorApp) updateQueueList() error {
-	ctx := context.Background()
+// maxQueues is the number of queues listed on the queue page
+const maxQueues = 100
 
-	// Fetch queues using River's QueueList API
-	result, err := m.client.RiverClient.QueueList(ctx, river.NewQueueListParams().First(100))
-	if err != nil {
-		return fmt.Errorf("failed to list queues: %w", err)
-	}
+// loadQueueList refreshes the queue list table in the background
+func (m *MonitorApp) loadQueueList() {
+	cli := m.client
+	m.load(func(ctx context.Context) (func(), error) {
+		queues, err := cli.QueueList(ctx, maxQueues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list queues: %w", err)
+		}
+		return func() { m.renderQueueList(queues) }, nil
+	})
+}
 
-	if len(result.Queues) == 0 {
+// renderQueueList displays the queues in the queue list table
+func (m *MonitorApp) renderQueueList(queues []*rivertype.Queue) {
+	if len(queues) == 0 {
 		m.ui.statusBar.SetText("No queues found")
-		return nil
+		return
 	}
 
 	m.ui.queueList.Clear()
 	m.setQueueTableHeaders()
 
 	// Add queues to table
-	for i, queue := range result.Queues {
+	for i, queue := range queues {
 		m.addQueueToTable(i+1, queue)
 	}
-
-	return nil
 }
 
 func (m *MonitorApp) setQueueTableHeaders() {
@@ -81,17 +85,33 @@ func (m *MonitorApp) showQueues() {
 	m.setQueueModeStatus()
 
 	// Update queue list when switching to queue view
-	if err := m.updateQueueList(); err != nil {
-		m.ui.statusBar.SetText(fmt.Sprintf("Error: %v", err))
-	}
+	m.loadQueueList()
 }
 
 func (m *MonitorApp) setQueueModeStatus() {
-	m.ui.statusBar.SetText("[#60A5FA]Mode:[white] Queues | Esc: Back to jobs | p: Pause queue | r: Resume queue | q: Quit")
+	m.setModeStatus("Queues",
+		[]string{"Enter: View jobs", "Esc: Back to jobs"},
+		[]string{"p: Pause queue", "r: Resume queue"})
+}
+
+// showQueueJobs shows the job list filtered on the selected queue
+func (m *MonitorApp) showQueueJobs() {
+	row, _ := m.ui.queueList.GetSelection()
+	if row <= 0 {
+		return
+	}
+	m.filter.SetQueueFilter([]string{m.ui.queueList.GetCell(row, 0).Text})
+	m.pagination.Reset()
+	m.scrollToBeginning = true
+	m.updateFilterStatusBar()
+	m.showJobList()
 }
 
 // handleQueuePause pauses the selected queue
 func (m *MonitorApp) handleQueuePause() {
+	if !m.allowMutation() {
+		return
+	}
 	row, _ := m.ui.queueList.GetSelection()
 	if row > 0 {
 		queueName := m.ui.queueList.GetCell(row, 0).Text
@@ -101,6 +121,9 @@ func (m *MonitorApp) handleQueuePause() {
 
 // handleQueueResume resumes the selected queue
 func (m *MonitorApp) handleQueueResume() {
+	if !m.allowMutation() {
+		return
+	}
 	row, _ := m.ui.queueList.GetSelection()
 	if row > 0 {
 		queueName := m.ui.queueList.GetCell(row, 0).Text
@@ -127,29 +150,39 @@ func (m *MonitorApp) showQueueResumeConfirmation(queueName string) {
 }
 
 func (m *MonitorApp) pauseQueue(queueName string) {
-	ctx := context.Background()
-	err := m.client.RiverClient.QueuePause(ctx, queueName, nil)
-	if err != nil {
-		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error pausing queue: %v[white]", err))
-	} else {
-		m.setQueueModeStatus()
-		// Refresh queue list
-		if err := m.updateQueueList(); err != nil {
-			m.ui.statusBar.SetText(fmt.Sprintf("Error: %v", err))
-		}
+	if !m.allowMutation() {
+		return
 	}
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		if err := cli.QueuePause(ctx, queueName); err != nil {
+			return nil, err
+		}
+		return func() {
+			m.setQueueModeStatus()
+			// Refresh queue list
+			m.loadQueueList()
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error pausing queue: %v[white]", err))
+	})
 }
 
 func (m *MonitorApp) resumeQueue(queueName string) {
-	ctx := context.Background()
-	err := m.client.RiverClient.QueueResume(ctx, queueName, nil)
-	if err != nil {
-		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error resuming queue: %v[white]", err))
-	} else {
-		m.setQueueModeStatus()
-		// Refresh queue list
-		if err := m.updateQueueList(); err != nil {
-			m.ui.statusBar.SetText(fmt.Sprintf("Error: %v", err))
-		}
+	if !m.allowMutation() {
+		return
 	}
+	cli := m.client
+	m.fetcher.Do(func(ctx context.Context) (func(), error) {
+		if err := cli.QueueResume(ctx, queueName); err != nil {
+			return nil, err
+		}
+		return func() {
+			m.setQueueModeStatus()
+			// Refresh queue list
+			m.loadQueueList()
+		}, nil
+	}, func(err error) {
+		m.ui.statusBar.SetText(fmt.Sprintf("[red]Error resuming queue: %v[white]", err))
+	})
 }
@@ -0,0 +1,127 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+)
+
+const (
+	// notifyPollInterval is how often to poll while change notifications are
+	// received, to pick up changes River doesn't notify about such as completions
+	notifyPollInterval = 10 * time.Second
+	// listenRetryInterval is how long to wait before resubscribing after the
+	// listener connection fails
+	listenRetryInterval = 5 * time.Second
+)
+
+// loadFrontPage loads the data of the page currently shown
+func (m *MonitorApp) loadFrontPage() {
+	page, _ := m.ui.pages.GetFrontPage()
+	switch page {
+	case PageQueues:
+		m.loadQueueList()
+	case PageDashboard:
+		m.loadDashboard()
+	case PageCharts:
+		m.loadCharts()
+	case PageProfiles:
+		// Profiles come from the config file, nothing to refresh
+		m.fetcher.Cancel(viewFetch)
+	case PageDetails:
+		m.loadJobDetails()
+	case PageErrors:
+		m.loadJobErrors()
+	default:
+		// Default to refreshing job list for other pages
+		m.loadJobList()
+	}
+}
+
+// refresh reloads the front page unless it is still loading, and returns the
+// refresh interval of the active configuration
+func (m *MonitorApp) refresh() time.Duration {
+	interval := make(chan time.Duration, 1)
+	m.ui.app.QueueUpdateDraw(func() {
+		// Let a slow query finish rather than superseding it with the same one
+		if !m.fetcher.Loading(viewFetch) {
+			m.loadFrontPage()
+		}
+		interval <- m.config.RefreshInterval
+	})
+	return <-interval
+}
+
+// StartRefreshLoop begins the background refresh loop. While change
+// notifications are received, the front page is refreshed when River reports
+// a change and polled every notifyPollInterval; otherwise it is polled every
+// refresh interval.
+func (m *MonitorApp) StartRefreshLoop() {
+	go func() {
+		for {
+			interval := m.refresh()
+
+			// Never refresh more often than the configured interval
+			time.Sleep(interval)
+			if !m.listening.Load() {
+				continue
+			}
+
+			timer := time.NewTimer(max(notifyPollInterval-interval, 0))
+			select {
+			case <-m.changes:
+			case <-timer.C:
+			}
+			timer.Stop()
+		}
+	}()
+}
+
+// startListener subscribes to change notifications of the active client,
+// replacing the previous subscription
+func (m *MonitorApp) startListener() {
+	m.stopListener()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.listenCancel = cancel
+	go m.listen(ctx, m.client)
+}
+
+// stopListener ends the active subscription, if any
+func (m *MonitorApp) stopListener() {
+	if m.listenCancel != nil {
+		m.listenCancel()
+		m.listenCancel = nil
+	}
+}
+
+// listen forwards change notifications to the refresh loop until the context
+// is done, resubscribing whenever the listener connection fails
+func (m *MonitorApp) listen(ctx context.Context, cli DataSource) {
+	for ctx.Err() == nil {
+		listener, err := cli.Listen(ctx, client.ChangeChannels...)
+		if err == nil {
+			m.listening.Store(true)
+			for {
+				if _, err := listener.Wait(ctx); err != nil {
+					break
+				}
+				select {
+				case m.changes <- struct{}{}:
+				default:
+					// A refresh is already pending
+				}
+			}
+			listener.Close()
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		m.listening.Store(false)
+		select {
+		case <-ctx.Done():
+		case <-time.After(listenRetryInterval):
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rivo/tview"
+)
+
+// SearchError is a syntax error in a search expression
+type SearchError struct {
+	Pos int // 1-based column of the offending term
+	Msg string
+}
+
+func (e *SearchError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Pos, e.Msg)
+}
+
+// searchTerm is a term of a search expression that isn't a kind, state or queue filter
+type searchTerm struct {
+	raw   string
+	apply func(query *client.JobListQuery)
+	// highlight is the text to highlight in the details of matching jobs
+	highlight string
+}
+
+// Search is a parsed search expression. Kinds, states and queues are nil
+// when the expression doesn't mention them.
+type Search struct {
+	Kinds        []string
+	ExcludeKinds []string
+	States       []rivertype.JobState
+	Queues       []string
+	terms        []searchTerm
+}
+
+// searchTimeFields maps the time fields of search expressions to their columns
+var searchTimeFields = map[string]string{
+	"created":   "created_at",
+	"scheduled": "scheduled_at",
+	"attempted": "attempted_at",
+	"finalized": "finalized_at",
+}
+
+// searchNumberFields maps the numeric fields of search expressions to their columns
+var searchNumberFields = map[string]string{
+	"id":       "id",
+	"attempt":  "attempt",
+	"max":      "max_attempts",
+	"priority": "priority",
+}
+
+const searchFields = "kind, state, queue, tag, id, attempt, max, priority, created, scheduled, attempted, finalized, args, metadata, errors, text"
+
+// searchTextColumns maps the text search fields to the columns they search
+var searchTextColumns = map[string][]string{
+	"args":     {"args"},
+	"metadata": {"metadata"},
+	"errors":   {"errors"},
+	"text":     {"args", "metadata", "errors"},
+}
+
+// ParseSearch parses a search expression such as
+//
+//	kind:Email* state:retryable,discarded queue:mail attempt>2 created>1h args.user_id=42 tag:urgent
+//
+// Terms are separated by spaces and all have to match. Values containing
+// spaces can be double-quoted. A term without a field filters on the kind,
+// unless it is quoted: "customer 1234" searches args, metadata and errors.
+// Kinds are excluded with a leading -, as in -HeartbeatJob or -kind:A,B, or
+// with kind!=A,B.
+// The ~ operator searches text, as in args~1234 or errors~timeout.
+// Times compare to a date or to a duration ago, so created>1h matches jobs
+// created within the last hour.
+func ParseSearch(input string) (*Search, error) {
+	tokens, err := tokenizeSearch(input)
+	if err != nil {
+		return nil, err
+	}
+
+	search := &Search{}
+	for _, token := range tokens {
+		if err := search.parseTerm(token); err != nil {
+			return nil, err
+		}
+	}
+	return search, nil
+}
+
+type searchToken struct {
+	offset int // byte offset of the token in the input
+	pos    int // 1-based column of the token, counting runes
+	text   string
+}
+
+// tokenizeSearch splits the input on spaces outside double quotes. Token
+// offsets index the input, while positions count runes so they match the
+// columns of the input field.
+func tokenizeSearch(input string) ([]searchToken, error) {
+	var tokens []searchToken
+	var current strings.Builder
+	start, startColumn, column, quoted := -1, 0, 0, false
+	for i, r := range input {
+		column++
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			if start >= 0 {
+				tokens = append(tokens, searchToken{offset: start, pos: startColumn, text: current.String()})
+				current.Reset()
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start, startColumn = i, column
+		}
+		current.WriteRune(r)
+	}
+	if quoted {
+		return nil, &SearchError{Pos: startColumn, Msg: "unterminated quote"}
+	}
+	if start >= 0 {
+		tokens = append(tokens, searchToken{offset: start, pos: startColumn, text: current.String()})
+	}
+	return tokens, nil
+}
+
+// splitTerm splits a term into its field, operator and unquoted value
+func splitTerm(text string) (field, op, value string) {
+	i := strings.IndexAny(text, ":=!<>~")
+	if i < 0 {
+		return "", "", unquote(text)
+	}
+	field, rest := text[:i], text[i:]
+	for _, candidate := range []string{">=", "<=", "!=", ":", "=", ">", "<", "~"} {
+		if strings.HasPrefix(rest, candidate) {
+			return field, candidate, unquote(rest[len(candidate):])
+		}
+	}
+	return field, rest[:1], unquote(rest[1:])
+}
+
+func unquote(value string) string {
+	return strings.ReplaceAll(value, `"`, "")
+}
+
+// splitList splits a comma-separated value, rejecting empty items
+func splitList(value string) ([]string, bool) {
+	items := strings.Split(value, ",")
+	for _, item := range items {
+		if item == "" {
+			return nil, false
+		}
+	}
+	return items, true
+}
+
+func (s *Search) parseTerm(token searchToken) error {
+	fail := func(format string, a ...any) error {
+		return &SearchError{Pos: token.pos, Msg: fmt.Sprintf(format, a...)}
+	}
+
+	if strings.HasPrefix(token.text, `"`) {
+		text := unquote(token.text)
+		if strings.Trim(text, "*") == "" {
+			return fail("empty search text")
+		}
+		s.addTextTerm(token.text, searchTextColumns["text"], nil, text)
+		return nil
+	}
+
+	text, exclude := strings.CutPrefix(token.text, "-")
+	field, op, value := splitTerm(text)
+	if op == "" {
+		if value == "" {
+			return fail("missing kind after -")
+		}
+		if exclude {
+			s.ExcludeKinds = append(s.ExcludeKinds, value)
+		} else {
+			s.Kinds = append(s.Kinds, value)
+		}
+		return nil
+	}
+	if field == "" {
+		return fail("missing field before %q", op)
+	}
+	if exclude && field != "kind" {
+		return fail("only kinds can be excluded with -, as in -kind:%s", value)
+	}
+	if op == "!" {
+		return fail("unknown operator after %s, expected one of : = != < <= > >= ~", field)
+	}
+	if value == "" {
+		return fail("missing value after %s%s", field, op)
+	}
+	isEquality := op == ":" || op == "="
+	column, path, hasPath := strings.Cut(field, ".")
+	if op == "~" {
+		columns := searchTextColumns[column]
+		if columns == nil || (hasPath && column != "args" && column != "metadata") {
+			return fail("~ only applies to args, metadata, errors, text and args or metadata paths")
+		}
+		var keys []string
+		if hasPath {
+			keys = strings.Split(path, ".")
+			if slices.Contains(keys, "") {
+				return fail("empty key in %s", field)
+			}
+		}
+		if strings.Trim(value, "*") == "" {
+			return fail("empty search text")
+		}
+		s.addTextTerm(token.text, columns, keys, value)
+		return nil
+	}
+
+	switch {
+	case field == "kind", field == "queue", field == "tag", field == "state":
+		switch {
+		case field == "kind" && op == "!=":
+			exclude = true
+		case field == "kind" && !isEquality:
+			return fail("kind only supports :, = or !=")
+		case !isEquality:
+			return fail("%s only supports : or =", field)
+		}
+		values, ok := splitList(value)
+		if !ok {
+			return fail("empty value in %s list", field)
+		}
+		switch field {
+		case "kind":
+			if exclude {
+				s.ExcludeKinds = append(s.ExcludeKinds, values...)
+			} else {
+				s.Kinds = append(s.Kinds, values...)
+			}
+		case "queue":
+			s.Queues = append(s.Queues, values...)
+		case "state":
+			states, err := ParseJobStates(values)
+			if err != nil {
+				return fail("%v", err)
+			}
+			s.States = append(s.States, states...)
+		case "tag":
+			s.addTerm(token.text, func(query *client.JobListQuery) {
+				query.Tags = append(query.Tags, values...)
+			})
+		}
+
+	case searchNumberFields[field] != "":
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fail("%s must be compared to an integer, got %q", field, value)
+		}
+		if op == ":" {
+			op = client.OpEqual
+		}
+		comparison := client.JobComparison{Field: searchNumberFields[field], Op: op, Value: number}
+		s.addTerm(token.text, func(query *client.JobListQuery) {
+			query.Comparisons = append(query.Comparisons, comparison)
+		})
+
+	case searchTimeFields[field] != "":
+		if isEquality || op == "!=" {
+			return fail("%s only supports <, <=, > and >=", field)
+		}
+		when, err := parseSearchTime(value)
+		if err != nil {
+			return fail("%s: %v", field, err)
+		}
+		comparison := client.JobComparison{Field: searchTimeFields[field], Op: op, Value: when}
+		s.addTerm(token.text, func(query *client.JobListQuery) {
+			query.Comparisons = append(query.Comparisons, comparison)
+		})
+
+	case hasPath && (column == "args" || column == "metadata"):
+		if !isEquality {
+			return fail("%s only supports :, = and ~", field)
+		}
+		keys := strings.Split(path, ".")
+		if slices.Contains(keys, "") {
+			return fail("empty key in %s", field)
+		}
+		match := client.JSONMatch{Column: column, Path: keys, Values: searchJSONValues(value)}
+		s.addTerm(token.text, func(query *client.JobListQuery) {
+			query.JSONMatches = append(query.JSONMatches, match)
+		})
+		s.terms[len(s.terms)-1].highlight = value
+
+	default:
+		return fail("unknown field %q, expected one of %s", field, searchFields)
+	}
+	return nil
+}
+
+func (s *Search) addTerm(raw string, apply func(query *client.JobListQuery)) {
+	s.terms = append(s.terms, searchTerm{raw: raw, apply: apply})
+}
+
+// addTextTerm adds a substring search of the columns
+func (s *Search) addTextTerm(raw string, columns, path []string, text string) {
+	match := client.NewTextMatch(columns, path, text)
+	s.terms = append(s.terms, searchTerm{
+		raw: raw,
+		apply: func(query *client.JobListQuery) {
+			query.TextMatches = append(query.TextMatches, match)
+		},
+		highlight: text,
+	})
+}
+
+// parseSearchTime parses a duration ago such as 90s, 15m, 2h or 3d, or a
+// date or RFC 3339 time
+func parseSearchTime(value string) (any, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil {
+			return time.Duration(n) * 24 * time.Hour, nil
+		}
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return duration, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return nil, fmt.Errorf("expected a duration ago (15m, 2h, 3d) or a date, got %q", value)
+}
+
+// searchJSONValues returns the JSON values a search value may stand for:
+// numbers, booleans and null also match their string form
+func searchJSONValues(value string) []any {
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+		if _, isString := decoded.(string); !isString {
+			return []any{json.RawMessage(value), value}
+		}
+	}
+	return []any{value}
+}
+
+// highlightPattern returns a case-insensitive pattern matching any of the
+// texts, with * wildcards, or nil when there are none
+func highlightPattern(texts []string) *regexp.Regexp {
+	if len(texts) == 0 {
+		return nil
+	}
+	alternatives := make([]string, len(texts))
+	for i, text := range texts {
+		alternatives[i] = strings.ReplaceAll(regexp.QuoteMeta(text), `\*`, ".*?")
+	}
+	return regexp.MustCompile("(?i)" + strings.Join(alternatives, "|"))
+}
+
+// highlight escapes the text for display, showing matches of the pattern in reverse video
+func highlight(text string, pattern *regexp.Regexp) string {
+	if pattern == nil {
+		return tview.Escape(text)
+	}
+	var out strings.Builder
+	last := 0
+	for _, match := range pattern.FindAllStringIndex(text, -1) {
+		if match[0] == match[1] {
+			continue
+		}
+		out.WriteString(tview.Escape(text[last:match[0]]))
+		out.WriteString("[::r]" + tview.Escape(text[match[0]:match[1]]) + "[::-]")
+		last = match[1]
+	}
+	out.WriteString(tview.Escape(text[last:]))
+	return out.String()
+}
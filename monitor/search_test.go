@@ -0,0 +1,131 @@
+package monitor
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/riverqueue/river/rivertype"
+)
+
+func TestParseSearch(t *testing.T) {
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		input string
+		want  Search
+		// query is what the terms other than kinds, states and queues add to a job list query
+		query client.JobListQuery
+	}{
+		{input: "", want: Search{}},
+		{input: "EmailJob Report*", want: Search{Kinds: []string{"EmailJob", "Report*"}}},
+		{input: "kind:A,B kind=C", want: Search{Kinds: []string{"A", "B", "C"}}},
+		{input: "-HeartbeatJob -kind:A,B kind!=C", want: Search{ExcludeKinds: []string{"HeartbeatJob", "A", "B", "C"}}},
+		{input: "state:retryable,discarded", want: Search{States: []rivertype.JobState{rivertype.JobStateRetryable, rivertype.JobStateDiscarded}}},
+		{input: "  queue:mail   queue=default,reports ", want: Search{Queues: []string{"mail", "default", "reports"}}},
+		{input: "tag:urgent,vip", query: client.JobListQuery{Tags: []string{"urgent", "vip"}}},
+		{
+			input: "id=42 attempt>2 max<=5 priority:1",
+			query: client.JobListQuery{Comparisons: []client.JobComparison{
+				{Field: "id", Op: client.OpEqual, Value: int64(42)},
+				{Field: "attempt", Op: client.OpGreater, Value: int64(2)},
+				{Field: "max_attempts", Op: client.OpLessOrEqual, Value: int64(5)},
+				{Field: "priority", Op: client.OpEqual, Value: int64(1)},
+			}},
+		},
+		{
+			input: "created>1h finalized<3d scheduled>=90s attempted<2024-05-01",
+			query: client.JobListQuery{Comparisons: []client.JobComparison{
+				{Field: "created_at", Op: client.OpGreater, Value: time.Hour},
+				{Field: "finalized_at", Op: client.OpLess, Value: 72 * time.Hour},
+				{Field: "scheduled_at", Op: client.OpGreaterOrEqual, Value: 90 * time.Second},
+				{Field: "attempted_at", Op: client.OpLess, Value: date},
+			}},
+		},
+		{
+			input: `"customer 1234" errors~timeout args.order~"big sale"`,
+			query: client.JobListQuery{TextMatches: []client.TextMatch{
+				client.NewTextMatch([]string{"args", "metadata", "errors"}, nil, "customer 1234"),
+				client.NewTextMatch([]string{"errors"}, nil, "timeout"),
+				client.NewTextMatch([]string{"args"}, []string{"order"}, "big sale"),
+			}},
+		},
+		{
+			input: `args.user.id=42 metadata.source:"web app"`,
+			query: client.JobListQuery{JSONMatches: []client.JSONMatch{
+				{Column: "args", Path: []string{"user", "id"}, Values: []any{json.RawMessage("42"), "42"}},
+				{Column: "metadata", Path: []string{"source"}, Values: []any{"web app"}},
+			}},
+		},
+		{
+			input: "kind:EmailJob state:available tag:urgent",
+			want:  Search{Kinds: []string{"EmailJob"}, States: []rivertype.JobState{rivertype.JobStateAvailable}},
+			query: client.JobListQuery{Tags: []string{"urgent"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			search, err := ParseSearch(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var query client.JobListQuery
+			for _, term := range search.terms {
+				term.apply(&query)
+			}
+			search.terms = nil
+			if !reflect.DeepEqual(*search, tt.want) {
+				t.Errorf("got search %+v, want %+v", *search, tt.want)
+			}
+			if !reflect.DeepEqual(query, tt.query) {
+				t.Errorf("got query %+v, want %+v", query, tt.query)
+			}
+		})
+	}
+}
+
+func TestParseSearchErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+	}{
+		{`kind:A "unterminated`, 8},
+		{"-", 1},
+		{"kind:A :B", 8},
+		{"queue:mail -queue:x", 12},
+		{"attempt!2", 1},
+		{"kind:", 1},
+		{"kind:A,,B", 1},
+		{"kind>A", 1},
+		{"queue!=mail", 1},
+		{"state:waiting", 1},
+		{"attempt>two", 1},
+		{"created=1h", 1},
+		{"created>yesterday", 1},
+		{"args.=1", 1},
+		{"args.a<1", 1},
+		{"kind~x", 1},
+		{"errors.code~x", 1},
+		{"args~**", 1},
+		{`""`, 1},
+		{"colour:red", 1},
+		// Columns count runes, so they match the input field after non-ASCII text
+		{"args~café attempt>x", 11},
+		{`"crème brûlée" état:x`, 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseSearch(tt.input)
+			var searchErr *SearchError
+			if !errors.As(err, &searchErr) {
+				t.Fatalf("got error %v, want a search error", err)
+			}
+			if searchErr.Pos != tt.pos {
+				t.Errorf("got column %d, want %d (%s)", searchErr.Pos, tt.pos, searchErr.Msg)
+			}
+		})
+	}
+}
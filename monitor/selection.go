@@ -0,0 +1,107 @@
+package monitor
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// jobIDAtRow returns the job ID displayed on the given job list row
+func (m *MonitorApp) jobIDAtRow(row int) (int64, bool) {
+	if row <= 0 || row >= m.ui.jobList.GetRowCount() {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(m.ui.jobList.GetCell(row, 0).Text, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// setRowMarked highlights or clears the highlight of a job list row
+func (m *MonitorApp) setRowMarked(row int, marked bool) {
+	for col := 0; col < m.ui.jobList.GetColumnCount(); col++ {
+		cell := m.ui.jobList.GetCell(row, col)
+		if marked {
+			cell.SetBackgroundColor(ColorMarkedBg)
+		} else {
+			cell.SetTransparency(true)
+		}
+	}
+}
+
+// toggleMark flips the mark on the selected row and moves the selection down
+func (m *MonitorApp) toggleMark() {
+	row, _ := m.ui.jobList.GetSelection()
+	id, ok := m.jobIDAtRow(row)
+	if !ok {
+		return
+	}
+	m.selection.Toggle(id)
+	m.setRowMarked(row, m.selection.IsMarked(id))
+	if row+1 < m.ui.jobList.GetRowCount() {
+		m.ui.jobList.Select(row+1, 0)
+	}
+	m.updateJobListTitle()
+}
+
+// markRange marks every row between the last toggled row and the selected row
+func (m *MonitorApp) markRange() {
+	row, _ := m.ui.jobList.GetSelection()
+	if _, ok := m.jobIDAtRow(row); !ok {
+		return
+	}
+
+	anchorRow := row
+	for r := 1; r < m.ui.jobList.GetRowCount(); r++ {
+		if id, ok := m.jobIDAtRow(r); ok && id == m.selection.anchor {
+			anchorRow = r
+			break
+		}
+	}
+
+	from, to := min(anchorRow, row), max(anchorRow, row)
+	for r := from; r <= to; r++ {
+		if id, ok := m.jobIDAtRow(r); ok {
+			m.selection.Mark(id)
+			m.setRowMarked(r, true)
+		}
+	}
+	m.updateJobListTitle()
+}
+
+// invertMarks inverts the marks of every row on the current page
+func (m *MonitorApp) invertMarks() {
+	for r := 1; r < m.ui.jobList.GetRowCount(); r++ {
+		if id, ok := m.jobIDAtRow(r); ok {
+			m.selection.Toggle(id)
+			m.setRowMarked(r, m.selection.IsMarked(id))
+		}
+	}
+	m.updateJobListTitle()
+}
+
+// clearMarks removes all marks
+func (m *MonitorApp) clearMarks() {
+	m.selection.Clear()
+	for r := 1; r < m.ui.jobList.GetRowCount(); r++ {
+		m.setRowMarked(r, false)
+	}
+	m.updateJobListTitle()
+}
+
+// handleMarkedAction asks for confirmation and applies the action to every marked job
+func (m *MonitorApp) handleMarkedAction(action jobAction) {
+	if !m.allowMutation() {
+		return
+	}
+	if m.batchCancel != nil {
+		m.ui.statusBar.SetText("[yellow]A bulk operation is already running[white]")
+		return
+	}
+
+	ids := m.selection.IDs()
+	m.confirmBatch(fmt.Sprintf("%s Marked Jobs", action.title), "marked jobs", action, ids, func() {
+		m.clearMarks()
+		m.runBatch(action, ids)
+	})
+}
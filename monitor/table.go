@@ -5,68 +5,63 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/riverqueue/river"
+	"github.com/almottier/rivertui/internal/client"
+	"github.com/gdamore/tcell/v2"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/rivo/tview"
 )
 
-// updateJobList refreshes the job list table
-func (m *MonitorApp) updateJobList() error {
-	ctx := context.Background()
+// jobListQuery builds the list query for the active filter and page
+func (m *MonitorApp) jobListQuery() client.JobListQuery {
+	query := m.filter.Query(m.pagination.pageSize)
+	m.sort.Apply(&query)
 
-	// Build ListJobsOpts
-	opts := river.NewJobListParams().
-		First(m.pagination.pageSize).
-		OrderBy(river.JobListOrderByField("id"), river.SortOrderDesc)
-
-	// Apply filters
-	opts = m.filter.ApplyToParams(opts)
+	// Start after the previous page if we're not on first page
+	query.After = m.pagination.GetCurrentCursor()
+	return query
+}
 
-	// Apply cursor for pagination if we're not on first page
-	if cursor := m.pagination.GetCurrentCursor(); cursor != nil {
-		opts = opts.After(cursor)
-	}
+// loadJobList refreshes the job list table in the background
+func (m *MonitorApp) loadJobList() {
+	cli := m.client
+	query := m.jobListQuery()
+	m.load(func(ctx context.Context) (func(), error) {
+		jobs, err := cli.JobList(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs: %w", err)
+		}
+		return func() { m.renderJobList(jobs) }, nil
+	})
+}
 
-	// Fetch jobs
-	result, err := m.client.RiverClient.JobList(ctx, opts)
-	if err != nil {
-		return fmt.Errorf("failed to list jobs: %w", err)
-	}
+// showJobList switches to the job list and reloads it
+func (m *MonitorApp) showJobList() {
+	m.ui.pages.SwitchToPage(PageList)
+	m.ui.app.SetFocus(m.ui.jobList)
+	m.setListModeStatus()
+	m.loadJobList()
+}
 
+// renderJobList displays a page of jobs in the job list table
+func (m *MonitorApp) renderJobList(jobs []*rivertype.JobRow) {
 	// Update pagination state
-	m.pagination.totalJobsOnPage = len(result.Jobs)
-	m.pagination.lastCursor = result.LastCursor
-	m.pagination.hasNextPage = result.LastCursor != nil && len(result.Jobs) == m.pagination.pageSize
-
-	// Collect unique kinds for modal
-	kindSet := make(map[string]struct{})
-	for _, job := range result.Jobs {
-		kindSet[job.Kind] = struct{}{}
-	}
-	m.lastJobKinds = m.lastJobKinds[:0]
-	for kind := range kindSet {
-		m.lastJobKinds = append(m.lastJobKinds, kind)
-	}
+	m.pagination.Update(jobs)
 
 	// Clear existing table
 	m.ui.jobList.Clear()
 
 	// Update the title with pagination info
-	paginationInfo := ""
-	if m.pagination.hasNextPage || m.pagination.currentPage > 1 {
-		paginationInfo = fmt.Sprintf(" (Page %d)", m.pagination.currentPage)
-	}
-	m.ui.jobList.SetTitle(fmt.Sprintf(" 🚀 Jobs%s ", paginationInfo))
+	m.updateJobListTitle()
 
 	// Set headers
 	m.setTableHeaders()
 
 	// Add jobs to table
-	for i, job := range result.Jobs {
+	for i, job := range jobs {
 		m.addJobToTable(i+1, job)
 	}
 
-	if m.scrollToBeginning && len(result.Jobs) > 0 {
+	if m.scrollToBeginning && len(jobs) > 0 {
 		m.ui.jobList.ScrollToBeginning()
 		m.ui.jobList.Select(1, 0)
 		m.scrollToBeginning = false
@@ -74,13 +69,26 @@ func (m *MonitorApp) updateJobList() error {
 
 	// Update status bar
 	m.setListModeStatus()
+}
 
-	return nil
+// updateJobListTitle renders the job list title with pagination and selection info
+func (m *MonitorApp) updateJobListTitle() {
+	info := ""
+	if m.pagination.hasNextPage || m.pagination.currentPage > 1 {
+		info = fmt.Sprintf(" (Page %d)", m.pagination.currentPage)
+	}
+	if count := m.selection.Count(); count > 0 {
+		info += fmt.Sprintf(" (%d marked)", count)
+	}
+	m.ui.jobList.SetTitle(fmt.Sprintf(" 🚀 Jobs%s%s ", info, m.connectionSuffix()))
 }
 
 func (m *MonitorApp) setTableHeaders() {
 	headers := []string{"ID", "KIND", "STATE", "ATTEMPT", "ERRORS", "DURATION", "CREATED", "SCHEDULED", "LAST_ATTEMPT", "FINALIZED", "QUEUE"}
 	for i, header := range headers {
+		if i == m.sort.Column() {
+			header += " " + m.sort.Arrow()
+		}
 		m.ui.jobList.SetCell(0, i,
 			tview.NewTableCell(header).
 				SetTextColor(ColorTitle).
@@ -125,27 +133,36 @@ func (m *MonitorApp) addJobToTable(row int, job *rivertype.JobRow) {
 	}
 
 	m.ui.jobList.SetCell(row, 10, tview.NewTableCell(job.Queue).SetTextColor(ColorTertiary))
+
+	if m.selection.IsMarked(job.ID) {
+		m.setRowMarked(row, true)
+	}
 }
 
 func (m *MonitorApp) createStateCell(state rivertype.JobState) *tview.TableCell {
-	stateCell := tview.NewTableCell(string(state))
+	return tview.NewTableCell(string(state)).SetTextColor(stateColor(state))
+}
+
+// stateColor returns the color used to display a job state
+func stateColor(state rivertype.JobState) tcell.Color {
 	switch state {
 	case rivertype.JobStateAvailable:
-		stateCell.SetTextColor(ColorAvailable)
+		return ColorAvailable
 	case rivertype.JobStateRunning:
-		stateCell.SetTextColor(ColorInfo)
+		return ColorInfo
 	case rivertype.JobStateCompleted:
-		stateCell.SetTextColor(ColorSuccess)
+		return ColorSuccess
 	case rivertype.JobStateDiscarded:
-		stateCell.SetTextColor(ColorError)
+		return ColorError
 	case rivertype.JobStateCancelled:
-		stateCell.SetTextColor(ColorCancelled)
+		return ColorCancelled
 	case rivertype.JobStateRetryable:
-		stateCell.SetTextColor(ColorRetryable)
+		return ColorRetryable
 	case rivertype.JobStateScheduled:
-		stateCell.SetTextColor(ColorScheduled)
+		return ColorScheduled
+	default:
+		return ColorPrimary
 	}
-	return stateCell
 }
 
 func (m *MonitorApp) setDurationCell(row int, job *rivertype.JobRow) {
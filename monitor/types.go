@@ -1,12 +1,16 @@
 package monitor
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"slices"
+	"sort"
 	"strings"
+	"sync/atomic"
 
 	"github.com/almottier/rivertui/config"
 	"github.com/almottier/rivertui/internal/client"
-	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/rivo/tview"
 )
@@ -18,6 +22,17 @@ const (
 	PageKindFilter   = "kindFilter"
 	PageConfirmation = "confirmation"
 	PageQueues       = "queues"
+	PageProgress     = "progress"
+	PageProfiles     = "profiles"
+	PageDashboard    = "dashboard"
+	PageCharts       = "charts"
+	PageQueueFilter  = "queueFilter"
+
+	PageDeleteConfirmation = "deleteConfirmation"
+	PageInsertForm         = "insertForm"
+	PageUpdateForm         = "updateForm"
+	PageErrors             = "errors"
+	PageHelp               = "help"
 )
 
 // State filter configuration
@@ -57,27 +72,52 @@ func (sfc *StateFilterConfig) GetStateByNumber(num int) rivertype.JobState {
 	return sfc.States[num-1]
 }
 
+// ParseJobStates converts state names into job states, rejecting unknown names
+func ParseJobStates(names []string) ([]rivertype.JobState, error) {
+	sfc := newStateFilterConfig()
+	states := make([]rivertype.JobState, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		valid := false
+		for _, state := range sfc.States {
+			if string(state) == name {
+				states = append(states, state)
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("unknown job state %q", name)
+		}
+	}
+	return states, nil
+}
+
 // Pagination handles pagination logic
 type Pagination struct {
 	currentPage     int
 	pageSize        int
-	cursors         []*river.JobListCursor
-	lastCursor      *river.JobListCursor
+	cursors         []*rivertype.JobRow
+	lastCursor      *rivertype.JobRow
 	hasNextPage     bool
 	totalJobsOnPage int
 }
 
-func newPagination() *Pagination {
+// NewPagination creates a pagination state starting on the first page
+func NewPagination(pageSize int) *Pagination {
 	return &Pagination{
 		currentPage: 1,
-		pageSize:    50,
-		cursors:     make([]*river.JobListCursor, 0),
+		pageSize:    pageSize,
+		cursors:     make([]*rivertype.JobRow, 0),
 	}
 }
 
 func (p *Pagination) Reset() {
 	p.currentPage = 1
-	p.cursors = make([]*river.JobListCursor, 0)
+	p.cursors = make([]*rivertype.JobRow, 0)
 	p.lastCursor = nil
 	p.hasNextPage = false
 	p.totalJobsOnPage = 0
@@ -105,30 +145,60 @@ func (p *Pagination) PreviousPage() bool {
 	return true
 }
 
-func (p *Pagination) GetCurrentCursor() *river.JobListCursor {
+// GetCurrentCursor returns the last job of the previous page, nil on the first page
+func (p *Pagination) GetCurrentCursor() *rivertype.JobRow {
 	if p.currentPage > 1 && len(p.cursors) >= p.currentPage-1 {
 		return p.cursors[p.currentPage-2]
 	}
 	return nil
 }
 
+// StartAfter resets the pagination so that the first page begins after the given job
+func (p *Pagination) StartAfter(cursor *rivertype.JobRow) {
+	p.Reset()
+	if cursor != nil {
+		p.cursors = append(p.cursors, cursor)
+		p.currentPage = 2
+	}
+}
+
+// Update records the jobs fetched for the current page
+func (p *Pagination) Update(jobs []*rivertype.JobRow) {
+	p.totalJobsOnPage = len(jobs)
+	p.lastCursor = nil
+	if len(jobs) > 0 {
+		p.lastCursor = jobs[len(jobs)-1]
+	}
+	p.hasNextPage = p.lastCursor != nil && len(jobs) == p.pageSize
+}
+
+func (p *Pagination) PageSize() int {
+	return p.pageSize
+}
+
+func (p *Pagination) HasNextPage() bool {
+	return p.hasNextPage
+}
+
 // JobFilter handles job filtering logic
 type JobFilter struct {
-	stateFilter      []rivertype.JobState
-	kindFilter       []string
-	selectedStateNum int
-	stateConfig      *StateFilterConfig
+	stateFilter  []rivertype.JobState
+	kindFilter   []string
+	excludeKinds []string
+	queueFilter  []string
+	terms        []searchTerm
+	stateConfig  *StateFilterConfig
 }
 
-func newJobFilter() *JobFilter {
+// NewJobFilter creates a filter matching all jobs
+func NewJobFilter() *JobFilter {
 	return &JobFilter{
-		selectedStateNum: 0,
-		stateConfig:      newStateFilterConfig(),
+		stateConfig: newStateFilterConfig(),
 	}
 }
 
+// SetStateFilter filters on the numbered state alone, or on all states for 0
 func (jf *JobFilter) SetStateFilter(stateNum int) {
-	jf.selectedStateNum = stateNum
 	if stateNum == 0 {
 		jf.stateFilter = nil
 	} else {
@@ -139,18 +209,291 @@ func (jf *JobFilter) SetStateFilter(stateNum int) {
 	}
 }
 
+// ToggleState adds the numbered state to the filter or removes it, keeping
+// the states in number order. 0 clears the filter.
+func (jf *JobFilter) ToggleState(stateNum int) {
+	toggled := jf.stateConfig.GetStateByNumber(stateNum)
+	if toggled == "" {
+		jf.stateFilter = nil
+		return
+	}
+	var states []rivertype.JobState
+	for _, state := range jf.stateConfig.States {
+		if (state == toggled) != jf.HasState(state) {
+			states = append(states, state)
+		}
+	}
+	jf.stateFilter = states
+}
+
+// HasState reports whether the filter explicitly includes the state
+func (jf *JobFilter) HasState(state rivertype.JobState) bool {
+	return slices.Contains(jf.stateFilter, state)
+}
+
+// SetStates filters on the given states, or on all states when empty
+func (jf *JobFilter) SetStates(states []rivertype.JobState) {
+	jf.stateFilter = states
+}
+
+// SetKindFilter filters on the kinds, excluding those prefixed with -
 func (jf *JobFilter) SetKindFilter(kinds []string) {
-	jf.kindFilter = kinds
+	jf.kindFilter, jf.excludeKinds = nil, nil
+	for _, kind := range kinds {
+		if excluded, ok := strings.CutPrefix(kind, "-"); ok {
+			jf.excludeKinds = append(jf.excludeKinds, excluded)
+		} else {
+			jf.kindFilter = append(jf.kindFilter, kind)
+		}
+	}
+}
+
+func (jf *JobFilter) SetQueueFilter(queues []string) {
+	jf.queueFilter = queues
+}
+
+// ApplySearch replaces the filter with the one of a search expression
+func (jf *JobFilter) ApplySearch(search *Search) {
+	jf.SetStates(search.States)
+	jf.kindFilter = search.Kinds
+	jf.excludeKinds = search.ExcludeKinds
+	jf.queueFilter = search.Queues
+	jf.terms = search.terms
 }
 
-func (jf *JobFilter) ApplyToParams(opts *river.JobListParams) *river.JobListParams {
+// Expression returns the search expression equivalent to the filter
+func (jf *JobFilter) Expression() string {
+	parts := make([]string, 0, 4+len(jf.terms))
+	if len(jf.kindFilter) > 0 {
+		parts = append(parts, "kind:"+strings.Join(jf.kindFilter, ","))
+	}
+	if len(jf.excludeKinds) > 0 {
+		parts = append(parts, "-kind:"+strings.Join(jf.excludeKinds, ","))
+	}
+	if len(jf.stateFilter) > 0 {
+		states := make([]string, len(jf.stateFilter))
+		for i, state := range jf.stateFilter {
+			states[i] = string(state)
+		}
+		parts = append(parts, "state:"+strings.Join(states, ","))
+	}
+	if len(jf.queueFilter) > 0 {
+		parts = append(parts, "queue:"+strings.Join(jf.queueFilter, ","))
+	}
+	for _, term := range jf.terms {
+		parts = append(parts, term.raw)
+	}
+	return strings.Join(parts, " ")
+}
+
+// SearchSummary returns the kinds and expression terms of the filter, which
+// unlike states and queues have no dedicated control. Long kind lists are
+// shortened to their first kinds and a count of the others.
+func (jf *JobFilter) SearchSummary() string {
+	parts := make([]string, 0, 2+len(jf.terms))
+	if len(jf.kindFilter) > 0 {
+		parts = append(parts, compactList(jf.kindFilter, ""))
+	}
+	if len(jf.excludeKinds) > 0 {
+		parts = append(parts, compactList(jf.excludeKinds, "-"))
+	}
+	for _, term := range jf.terms {
+		parts = append(parts, term.raw)
+	}
+	return strings.Join(parts, " ")
+}
+
+// compactListSize is the number of values compactList shows before counting the others
+const compactListSize = 2
+
+// compactList joins the first values with commas, each with the prefix,
+// followed by the count of the values left out
+func compactList(values []string, prefix string) string {
+	shown := make([]string, 0, compactListSize+1)
+	for _, value := range values[:min(len(values), compactListSize)] {
+		shown = append(shown, prefix+value)
+	}
+	if len(values) > compactListSize {
+		shown = append(shown, fmt.Sprintf("+%d", len(values)-compactListSize))
+	}
+	return strings.Join(shown, ",")
+}
+
+// Highlights returns the text searched by the filter, to highlight in job details
+func (jf *JobFilter) Highlights() []string {
+	var highlights []string
+	for _, term := range jf.terms {
+		if term.highlight != "" {
+			highlights = append(highlights, term.highlight)
+		}
+	}
+	return highlights
+}
+
+// Describe returns a short human-readable summary of the active filters
+func (jf *JobFilter) Describe() string {
+	parts := make([]string, 0, 3)
 	if len(jf.stateFilter) > 0 {
-		opts = opts.States(jf.stateFilter...)
+		states := make([]string, len(jf.stateFilter))
+		for i, state := range jf.stateFilter {
+			states[i] = string(state)
+		}
+		parts = append(parts, "state="+strings.Join(states, ","))
 	}
 	if len(jf.kindFilter) > 0 {
-		opts = opts.Kinds(jf.kindFilter...)
+		parts = append(parts, "kind="+strings.Join(jf.kindFilter, ","))
+	}
+	if len(jf.excludeKinds) > 0 {
+		parts = append(parts, "kind!="+strings.Join(jf.excludeKinds, ","))
+	}
+	if len(jf.queueFilter) > 0 {
+		parts = append(parts, "queue="+strings.Join(jf.queueFilter, ","))
+	}
+	for _, term := range jf.terms {
+		parts = append(parts, term.raw)
+	}
+	if len(parts) == 0 {
+		return "all jobs"
+	}
+	return strings.Join(parts, " ")
+}
+
+// Query returns a query for the first page of jobs matching the filter
+func (jf *JobFilter) Query(limit int) client.JobListQuery {
+	query := client.JobListQuery{
+		States:       jf.stateFilter,
+		Kinds:        jf.kindFilter,
+		ExcludeKinds: jf.excludeKinds,
+		Queues:       jf.queueFilter,
+		Limit:        limit,
+	}
+	for _, term := range jf.terms {
+		term.apply(&query)
 	}
-	return opts
+	return query
+}
+
+// sortColumns are the job list columns that can be sorted, in the order 's' cycles through them
+var sortColumns = []struct {
+	field  client.JobSortField
+	column int // index of the column in the job list table
+	label  string
+}{
+	{client.JobSortID, 0, "id"},
+	{client.JobSortCreatedAt, 6, "created"},
+	{client.JobSortScheduledAt, 7, "scheduled"},
+	{client.JobSortAttemptedAt, 8, "last attempt"},
+	{client.JobSortFinalizedAt, 9, "finalized"},
+	{client.JobSortKind, 1, "kind"},
+	{client.JobSortQueue, 10, "queue"},
+	{client.JobSortAttempt, 3, "attempt"},
+}
+
+// JobSort tracks the column and direction the job list is sorted by
+type JobSort struct {
+	index     int
+	ascending bool
+}
+
+// ParseJobSort returns the sort by the given field, rejecting fields the job list can't sort by
+func ParseJobSort(field string, ascending bool) (*JobSort, error) {
+	names := make([]string, len(sortColumns))
+	for i, col := range sortColumns {
+		if string(col.field) == field {
+			return &JobSort{index: i, ascending: ascending}, nil
+		}
+		names[i] = string(col.field)
+	}
+	return nil, fmt.Errorf("unknown sort field %q, expected one of %s", field, strings.Join(names, ", "))
+}
+
+// NextColumn sorts by the next sortable column, descending
+func (js *JobSort) NextColumn() {
+	js.index = (js.index + 1) % len(sortColumns)
+	js.ascending = false
+}
+
+// ToggleDirection switches between ascending and descending order
+func (js *JobSort) ToggleDirection() {
+	js.ascending = !js.ascending
+}
+
+// Column returns the index of the sorted column in the job list table
+func (js *JobSort) Column() int {
+	return sortColumns[js.index].column
+}
+
+// Arrow returns the indicator shown next to the sorted column header
+func (js *JobSort) Arrow() string {
+	if js.ascending {
+		return "▲"
+	}
+	return "▼"
+}
+
+// Describe returns the sort as shown in the status bar
+func (js *JobSort) Describe() string {
+	return sortColumns[js.index].label + " " + js.Arrow()
+}
+
+// Apply sets the sort of the query
+func (js *JobSort) Apply(query *client.JobListQuery) {
+	query.SortField = sortColumns[js.index].field
+	query.Ascending = js.ascending
+}
+
+// JobSelection tracks jobs marked for batch operations across refreshes and pages
+type JobSelection struct {
+	marked map[int64]struct{}
+	anchor int64
+}
+
+func newJobSelection() *JobSelection {
+	return &JobSelection{
+		marked: make(map[int64]struct{}),
+	}
+}
+
+// Toggle flips the mark on a job and makes it the anchor for range selection
+func (js *JobSelection) Toggle(id int64) {
+	if js.IsMarked(id) {
+		delete(js.marked, id)
+	} else {
+		js.marked[id] = struct{}{}
+	}
+	js.anchor = id
+}
+
+func (js *JobSelection) Mark(id int64) {
+	js.marked[id] = struct{}{}
+}
+
+func (js *JobSelection) Unmark(id int64) {
+	delete(js.marked, id)
+}
+
+func (js *JobSelection) IsMarked(id int64) bool {
+	_, ok := js.marked[id]
+	return ok
+}
+
+func (js *JobSelection) Count() int {
+	return len(js.marked)
+}
+
+// IDs returns the marked job IDs, newest first
+func (js *JobSelection) IDs() []int64 {
+	ids := make([]int64, 0, len(js.marked))
+	for id := range js.marked {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	return ids
+}
+
+func (js *JobSelection) Clear() {
+	js.marked = make(map[int64]struct{})
+	js.anchor = 0
 }
 
 // UIComponents holds all UI components
@@ -159,27 +502,70 @@ type UIComponents struct {
 	pages             *tview.Pages
 	jobList           *tview.Table
 	jobDetails        *tview.TextView
+	errorList         *tview.Table
+	errorTrace        *tview.TextView
 	queueList         *tview.Table
+	profileList       *tview.Table
+	dashboard         *tview.TextView
+	charts            *tview.TextView
 	filterStatusBar   *tview.TextView
 	statusBar         *tview.TextView
+	fetchIndicator    *tview.TextView
 	kindFilterInput   *tview.InputField
+	queueFilterList   *tview.Table
 	confirmationModal *tview.TextView
+	progressModal     *tview.TextView
+	helpView          *tview.TextView
+
+	deleteConfirmation *tview.Flex
+	deleteConfirmText  *tview.TextView
+	deleteConfirmInput *tview.InputField
+
+	insertForm   *tview.Form
+	insertFields *insertFormFields
+
+	updateForm   *tview.Form
+	updateFields *updateFormFields
 }
 
-func newUIComponents() *UIComponents {
-	app := tview.NewApplication()
+// newUIComponents creates the widgets of the application with the current colors
+func newUIComponents(app *tview.Application) *UIComponents {
 	setupAppTheme()
 
+	deleteText := createDeleteConfirmText()
+	deleteInput := createDeleteConfirmInput()
+	insertFields := createInsertFormFields()
+	updateFields := createUpdateFormFields()
+
 	return &UIComponents{
 		app:               app,
 		pages:             tview.NewPages(),
 		jobList:           createJobListTable(),
 		jobDetails:        createJobDetailsView(),
+		errorList:         createErrorListTable(),
+		errorTrace:        createErrorTraceView(),
 		queueList:         createQueueListTable(),
+		profileList:       createProfileListTable(),
+		dashboard:         createDashboardView(),
+		charts:            createChartsView(),
 		filterStatusBar:   createStatusBar(),
 		statusBar:         createStatusBar(),
+		fetchIndicator:    createFetchIndicator(),
 		kindFilterInput:   createKindFilterInput(),
+		queueFilterList:   createQueueFilterTable(),
 		confirmationModal: createConfirmationModal(),
+		progressModal:     createProgressModal(),
+		helpView:          createHelpView(),
+
+		deleteConfirmation: createDeleteConfirmation(deleteText, deleteInput),
+		deleteConfirmText:  deleteText,
+		deleteConfirmInput: deleteInput,
+
+		insertForm:   createInsertForm(insertFields),
+		insertFields: insertFields,
+
+		updateForm:   createUpdateForm(updateFields),
+		updateFields: updateFields,
 	}
 }
 
@@ -218,20 +604,35 @@ func (ms *ModalState) ExecuteNo() {
 // MonitorApp represents the main TUI application
 type MonitorApp struct {
 	ui                *UIComponents
-	client            *client.Client
+	client            DataSource
+	connect           func(ctx context.Context, cfg *config.Config) (DataSource, error)
 	config            *config.Config
 	pagination        *Pagination
 	filter            *JobFilter
+	sort              JobSort
 	modalState        *ModalState
+	selection         *JobSelection
+	charts            *ChartState
+	queuePicker       *QueuePicker
 	currentJobID      string
 	initialJobID      int64
-	lastJobKinds      []string
+	completer         *SearchCompleter
+	stateToggle       bool
+	pendingDelete     *typedDeletion
+	pendingUpdate     *updateTarget
+	errorsJob         *rivertype.JobRow
+	clipboard         []byte
 	scrollToBeginning bool
 	lastActivePage    string
+	batchCancel       context.CancelFunc
+	fetcher           *fetcher
+	changes           chan struct{}
+	listening         atomic.Bool
+	listenCancel      context.CancelFunc
 }
 
 // NewMonitorApp creates a new monitor application
-func NewMonitorApp(cli *client.Client, cfg *config.Config, jobID int64, kindFilter string) *MonitorApp {
+func NewMonitorApp(cli DataSource, cfg *config.Config, jobID int64, stateFilter []rivertype.JobState, kindFilter, queueFilter []string) *MonitorApp {
 	// Set COLORTERM and TERM if not already set
 	if os.Getenv("COLORTERM") == "" {
 		os.Setenv("COLORTERM", "truecolor")
@@ -241,26 +642,65 @@ func NewMonitorApp(cli *client.Client, cfg *config.Config, jobID int64, kindFilt
 		os.Setenv("TERM", "xterm-256color")
 	}
 
-	ui := newUIComponents()
+	applyTheme(cfg.Theme)
+	ui := newUIComponents(tview.NewApplication())
 
 	monitor := &MonitorApp{
 		ui:                ui,
 		client:            cli,
+		connect:           connectPostgres,
 		config:            cfg,
-		pagination:        newPagination(),
-		filter:            newJobFilter(),
+		pagination:        NewPagination(50),
+		filter:            NewJobFilter(),
 		modalState:        newModalState(),
+		selection:         newJobSelection(),
+		charts:            newChartState(),
 		initialJobID:      jobID,
-		lastJobKinds:      make([]string, 0),
+		completer:         &SearchCompleter{},
 		scrollToBeginning: true,
+		changes:           make(chan struct{}, 1),
+		fetcher:           newFetcher(ui.app, fetchTimeout),
 	}
+	monitor.fetcher.onChange = monitor.updateFetchIndicator
+
+	monitor.applyConfigFilters()
 
-	// Set initial kind filter if provided
-	if kindFilter != "" {
-		monitor.filter.SetKindFilter([]string{kindFilter})
+	// Set initial state, kind and queue filters if provided, taking precedence over the profile
+	if len(stateFilter) > 0 {
+		monitor.filter.SetStates(stateFilter)
+	}
+	if len(kindFilter) > 0 {
+		monitor.filter.SetKindFilter(kindFilter)
+	}
+	if len(queueFilter) > 0 {
+		monitor.filter.SetQueueFilter(queueFilter)
 	}
 
 	monitor.setupUI()
 	monitor.setupKeyBindings()
 	return monitor
 }
+
+// applyConfigFilters applies the default filters of the active profile
+func (m *MonitorApp) applyConfigFilters() {
+	if states, err := ParseJobStates(m.config.Filters.States); err == nil {
+		m.filter.SetStates(states)
+	}
+	m.filter.SetKindFilter(m.config.Filters.Kinds)
+	m.filter.SetQueueFilter(m.config.Filters.Queues)
+}
+
+// allowMutation reports whether operations that modify jobs or queues are
+// permitted, explaining in the status bar when they are not
+func (m *MonitorApp) allowMutation() bool {
+	if m.config.ReadOnly {
+		m.ui.statusBar.SetText("[#F59E0B]Read-only mode: jobs and queues cannot be modified[white]")
+		return false
+	}
+	return true
+}
+
+// Client returns the active client, which changes when switching profiles
+func (m *MonitorApp) Client() DataSource {
+	return m.client
+}
@@ -49,6 +49,36 @@ func createJobDetailsView() *tview.TextView {
 	return view
 }
 
+func createErrorListTable() *tview.Table {
+	table := tview.NewTable()
+	table.SetSelectable(true, false)
+	table.SetFixed(1, 0)
+	table.SetTitle(" 🐞 Errors ")
+	table.SetBorder(true)
+	table.SetBorderPadding(0, 0, 1, 1)
+	table.SetBorderColor(ColorBorder)
+	table.SetTitleColor(ColorTitle)
+	table.SetBackgroundColor(ColorContrastBackground)
+	table.SetSelectedStyle(tcell.StyleDefault.
+		Background(ColorSelectedBg).
+		Foreground(ColorSelectedFg))
+	return table
+}
+
+func createErrorTraceView() *tview.TextView {
+	view := tview.NewTextView()
+	view.SetDynamicColors(true)
+	view.SetWrap(true)
+	view.SetWordWrap(true)
+	view.SetTitle(" Trace ")
+	view.SetBorder(true)
+	view.SetBorderPadding(0, 0, 1, 1)
+	view.SetBorderColor(ColorBorder)
+	view.SetTitleColor(ColorTitle)
+	view.SetBackgroundColor(ColorContrastBackground)
+	return view
+}
+
 func createStatusBar() *tview.TextView {
 	bar := tview.NewTextView()
 	bar.SetDynamicColors(true)
@@ -59,20 +89,47 @@ func createStatusBar() *tview.TextView {
 	return bar
 }
 
+func createFetchIndicator() *tview.TextView {
+	indicator := createStatusBar()
+	indicator.SetTextAlign(tview.AlignRight)
+	return indicator
+}
+
+const searchInputTitle = " 🔍 Search, e.g. kind:Email* state:retryable attempt>2 created>1h (Enter: Apply, Esc: Cancel) "
+
 func createKindFilterInput() *tview.InputField {
 	input := tview.NewInputField()
-	input.SetLabel("🔍 Filter by kind or job ID: ")
-	input.SetFieldWidth(20)
+	input.SetLabel("Filter or job ID: ")
+	input.SetFieldWidth(0)
 	input.SetBorder(true)
-	input.SetTitle(" 🏷️  Kind / ID Filter (Enter: Apply, Esc: Clear) ")
+	input.SetTitle(searchInputTitle)
 	input.SetLabelColor(ColorTitle)
 	input.SetBorderColor(ColorTitle)
 	input.SetTitleColor(ColorTitle)
 	input.SetBackgroundColor(ColorContrastBackground)
 	input.SetFieldBackgroundColor(ColorContrastBackground)
+	input.SetAutocompleteUseTags(false)
+	input.SetAutocompleteStyles(ColorMoreContrastBackground,
+		tcell.StyleDefault.Foreground(ColorPrimary).Background(ColorMoreContrastBackground),
+		tcell.StyleDefault.Foreground(ColorSelectedFg).Background(ColorSelectedBg))
 	return input
 }
 
+func createQueueFilterTable() *tview.Table {
+	table := tview.NewTable()
+	table.SetSelectable(true, false)
+	table.SetBorder(true)
+	table.SetBorderPadding(0, 0, 1, 1)
+	table.SetTitle(" 🔀 Queue Filter (Space: Toggle, Enter: Apply, Esc: Cancel) ")
+	table.SetBorderColor(ColorTitle)
+	table.SetTitleColor(ColorTitle)
+	table.SetBackgroundColor(ColorContrastBackground)
+	table.SetSelectedStyle(tcell.StyleDefault.
+		Background(ColorSelectedBg).
+		Foreground(ColorSelectedFg))
+	return table
+}
+
 func createConfirmationModal() *tview.TextView {
 	modal := tview.NewTextView()
 	modal.SetDynamicColors(true)
@@ -87,6 +144,165 @@ func createConfirmationModal() *tview.TextView {
 	return modal
 }
 
+func createProgressModal() *tview.TextView {
+	modal := tview.NewTextView()
+	modal.SetDynamicColors(true)
+	modal.SetWordWrap(true)
+	modal.SetTextAlign(tview.AlignCenter)
+	modal.SetBorder(true)
+	modal.SetTitle(" ⏳ Progress ")
+	modal.SetBorderColor(ColorInfo)
+	modal.SetTitleColor(ColorInfo)
+	modal.SetBackgroundColor(ColorContrastBackground)
+	return modal
+}
+
+func createHelpView() *tview.TextView {
+	view := tview.NewTextView()
+	view.SetDynamicColors(true)
+	view.SetWordWrap(true)
+	view.SetBorder(true)
+	view.SetBorderPadding(0, 0, 1, 1)
+	view.SetBorderColor(ColorInfo)
+	view.SetTitleColor(ColorInfo)
+	view.SetBackgroundColor(ColorContrastBackground)
+	return view
+}
+
+func createDeleteConfirmText() *tview.TextView {
+	text := tview.NewTextView()
+	text.SetDynamicColors(true)
+	text.SetWordWrap(true)
+	text.SetBackgroundColor(ColorContrastBackground)
+	return text
+}
+
+func createDeleteConfirmInput() *tview.InputField {
+	input := tview.NewInputField()
+	input.SetLabel("Job ID: ")
+	input.SetFieldWidth(0)
+	input.SetLabelColor(ColorError)
+	input.SetBackgroundColor(ColorContrastBackground)
+	input.SetFieldBackgroundColor(ColorMoreContrastBackground)
+	input.SetAcceptanceFunc(tview.InputFieldInteger)
+	return input
+}
+
+func createDeleteConfirmation(text *tview.TextView, input *tview.InputField) *tview.Flex {
+	modal := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(text, 0, 1, false).
+		AddItem(input, 1, 0, true)
+	modal.SetBorder(true)
+	modal.SetBorderPadding(0, 0, 1, 1)
+	modal.SetTitle(deleteConfirmTitle)
+	modal.SetBorderColor(ColorError)
+	modal.SetTitleColor(ColorError)
+	modal.SetBackgroundColor(ColorContrastBackground)
+	return modal
+}
+
+// insertFormFields are the fields of the form inserting a job
+type insertFormFields struct {
+	kind        *tview.InputField
+	args        *tview.TextArea
+	queue       *tview.InputField
+	priority    *tview.InputField
+	maxAttempts *tview.InputField
+	tags        *tview.InputField
+	scheduleAt  *tview.InputField
+	uniqueBy    *tview.InputField
+}
+
+func createInsertFormFields() *insertFormFields {
+	args := tview.NewTextArea()
+	args.SetLabel("Args (JSON)")
+	args.SetSize(5, 0)
+
+	fields := &insertFormFields{
+		kind:        createFormInputField("Kind"),
+		args:        args,
+		queue:       createFormInputField("Queue"),
+		priority:    createFormInputField("Priority (1-4)"),
+		maxAttempts: createFormInputField("Max attempts"),
+		tags:        createFormInputField("Tags"),
+		scheduleAt:  createFormInputField("Schedule at"),
+		uniqueBy:    createFormInputField("Unique by"),
+	}
+	fields.priority.SetAcceptanceFunc(tview.InputFieldInteger)
+	fields.maxAttempts.SetAcceptanceFunc(tview.InputFieldInteger)
+	fields.tags.SetPlaceholder("comma-separated")
+	fields.scheduleAt.SetPlaceholder("now, or 30m, 2h, 2025-01-02 15:04:05")
+	fields.uniqueBy.SetPlaceholder("args, queue, period=24h, state=available")
+	return fields
+}
+
+func createInsertForm(fields *insertFormFields) *tview.Form {
+	form := tview.NewForm()
+	form.SetItemPadding(0)
+	form.AddFormItem(fields.kind).
+		AddFormItem(fields.args).
+		AddFormItem(fields.queue).
+		AddFormItem(fields.priority).
+		AddFormItem(fields.maxAttempts).
+		AddFormItem(fields.tags).
+		AddFormItem(fields.scheduleAt).
+		AddFormItem(fields.uniqueBy)
+	form.SetTitle(insertFormTitle)
+	styleForm(form)
+	return form
+}
+
+func createFormInputField(label string) *tview.InputField {
+	field := tview.NewInputField()
+	field.SetLabel(label)
+	field.SetFieldWidth(0)
+	field.SetAutocompleteUseTags(false)
+	field.SetAutocompleteStyles(ColorMoreContrastBackground,
+		tcell.StyleDefault.Foreground(ColorPrimary).Background(ColorMoreContrastBackground),
+		tcell.StyleDefault.Foreground(ColorSelectedFg).Background(ColorSelectedBg))
+	return field
+}
+
+func styleForm(form *tview.Form) {
+	form.SetBorder(true)
+	form.SetBorderColor(ColorTitle)
+	form.SetTitleColor(ColorTitle)
+	form.SetLabelColor(ColorTitle)
+	form.SetBackgroundColor(ColorContrastBackground)
+	form.SetFieldBackgroundColor(ColorMoreContrastBackground)
+	form.SetFieldTextColor(ColorPrimary)
+	form.SetButtonBackgroundColor(ColorSelectedBg)
+	form.SetButtonTextColor(ColorSelectedFg)
+}
+
+// updateFormFields are the fields of the form rescheduling, reprioritizing or moving jobs
+type updateFormFields struct {
+	runAt    *tview.InputField
+	priority *tview.InputField
+	queue    *tview.InputField
+}
+
+func createUpdateFormFields() *updateFormFields {
+	fields := &updateFormFields{
+		runAt:    createFormInputField("Run at"),
+		priority: createFormInputField("Priority (1-4)"),
+		queue:    createFormInputField("Queue"),
+	}
+	fields.runAt.SetPlaceholder("now, +30m to postpone, 2h, 2025-01-02 15:04:05")
+	fields.priority.SetAcceptanceFunc(tview.InputFieldInteger)
+	return fields
+}
+
+func createUpdateForm(fields *updateFormFields) *tview.Form {
+	form := tview.NewForm()
+	form.SetItemPadding(0)
+	form.AddFormItem(fields.runAt).
+		AddFormItem(fields.priority).
+		AddFormItem(fields.queue)
+	styleForm(form)
+	return form
+}
+
 func createCenteredModal(component tview.Primitive, width, height int) *tview.Flex {
 	return tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(nil, 0, 1, false).
@@ -112,3 +328,45 @@ func createQueueListTable() *tview.Table {
 		Foreground(ColorSelectedFg))
 	return table
 }
+
+func createProfileListTable() *tview.Table {
+	table := tview.NewTable()
+	table.SetSelectable(true, false)
+	table.SetFixed(1, 0)
+	table.SetTitle(" 🔌 Profiles ")
+	table.SetBorder(true)
+	table.SetBorderPadding(0, 0, 1, 1)
+	table.SetBorderColor(ColorBorder)
+	table.SetTitleColor(ColorTitle)
+	table.SetBackgroundColor(ColorContrastBackground)
+	table.SetSelectedStyle(tcell.StyleDefault.
+		Background(ColorSelectedBg).
+		Foreground(ColorSelectedFg))
+	return table
+}
+
+func createDashboardView() *tview.TextView {
+	view := tview.NewTextView()
+	view.SetDynamicColors(true)
+	view.SetWrap(false)
+	view.SetTitle(" 📊 Dashboard ")
+	view.SetBorder(true)
+	view.SetBorderPadding(0, 0, 1, 1)
+	view.SetBorderColor(ColorBorder)
+	view.SetTitleColor(ColorTitle)
+	view.SetBackgroundColor(ColorContrastBackground)
+	return view
+}
+
+func createChartsView() *tview.TextView {
+	view := tview.NewTextView()
+	view.SetDynamicColors(true)
+	view.SetWrap(false)
+	view.SetTitle(" 📈 Charts ")
+	view.SetBorder(true)
+	view.SetBorderPadding(0, 0, 1, 1)
+	view.SetBorderColor(ColorBorder)
+	view.SetTitleColor(ColorTitle)
+	view.SetBackgroundColor(ColorContrastBackground)
+	return view
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/riverqueue/river/rivertype"
+)
+
+// Output formats
+const (
+	OutputTable  = "table"
+	OutputJSON   = "json"
+	OutputCSV    = "csv"
+	OutputNDJSON = "ndjson"
+)
+
+func parseOutputFormat(format string) (string, error) {
+	switch strings.ToLower(format) {
+	case OutputTable, OutputJSON, OutputCSV, OutputNDJSON:
+		return strings.ToLower(format), nil
+	default:
+		return "", fmt.Errorf("unknown output format %q (expected table, json, csv or ndjson)", format)
+	}
+}
+
+// jobOutput is the serialized form of a job for machine-readable output
+type jobOutput struct {
+	ID          int64                    `json:"id"`
+	Kind        string                   `json:"kind"`
+	State       rivertype.JobState       `json:"state"`
+	Queue       string                   `json:"queue"`
+	Priority    int                      `json:"priority"`
+	Attempt     int                      `json:"attempt"`
+	MaxAttempts int                      `json:"max_attempts"`
+	CreatedAt   time.Time                `json:"created_at"`
+	ScheduledAt time.Time                `json:"scheduled_at"`
+	AttemptedAt *time.Time               `json:"attempted_at"`
+	FinalizedAt *time.Time               `json:"finalized_at"`
+	AttemptedBy []string                 `json:"attempted_by"`
+	Args        json.RawMessage          `json:"args"`
+	Metadata    json.RawMessage          `json:"metadata"`
+	Tags        []string                 `json:"tags"`
+	Errors      []rivertype.AttemptError `json:"errors"`
+}
+
+func newJobOutput(job *rivertype.JobRow) jobOutput {
+	return jobOutput{
+		ID:          job.ID,
+		Kind:        job.Kind,
+		State:       job.State,
+		Queue:       job.Queue,
+		Priority:    job.Priority,
+		Attempt:     job.Attempt,
+		MaxAttempts: job.MaxAttempts,
+		CreatedAt:   job.CreatedAt,
+		ScheduledAt: job.ScheduledAt,
+		AttemptedAt: job.AttemptedAt,
+		FinalizedAt: job.FinalizedAt,
+		AttemptedBy: job.AttemptedBy,
+		Args:        rawJSON(job.EncodedArgs),
+		Metadata:    rawJSON(job.Metadata),
+		Tags:        job.Tags,
+		Errors:      job.Errors,
+	}
+}
+
+// rawJSON returns the bytes as raw JSON, falling back to null for empty or invalid input
+func rawJSON(b []byte) json.RawMessage {
+	if len(b) == 0 || !json.Valid(b) {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(b)
+}
+
+var jobColumns = []string{"ID", "KIND", "STATE", "QUEUE", "PRIORITY", "ATTEMPT", "ERRORS", "CREATED", "SCHEDULED", "LAST_ATTEMPT", "FINALIZED"}
+
+func jobRecord(job *rivertype.JobRow) []string {
+	return []string{
+		strconv.FormatInt(job.ID, 10),
+		job.Kind,
+		string(job.State),
+		job.Queue,
+		strconv.Itoa(job.Priority),
+		fmt.Sprintf("%d/%d", job.Attempt, job.MaxAttempts),
+		strconv.Itoa(len(job.Errors)),
+		job.CreatedAt.Format(time.RFC3339),
+		job.ScheduledAt.Format(time.RFC3339),
+		formatOptionalTime(job.AttemptedAt),
+		formatOptionalTime(job.FinalizedAt),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// jobWriter streams jobs to an output in the selected format
+type jobWriter struct {
+	format  string
+	out     io.Writer
+	table   *tabwriter.Writer
+	csv     *csv.Writer
+	count   int
+	started bool
+}
+
+func newJobWriter(out io.Writer, format string) *jobWriter {
+	w := &jobWriter{format: format, out: out}
+	switch format {
+	case OutputTable:
+		w.table = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	case OutputCSV:
+		w.csv = csv.NewWriter(out)
+	}
+	return w
+}
+
+func (w *jobWriter) writeHeader() error {
+	w.started = true
+	switch w.format {
+	case OutputTable:
+		_, err := fmt.Fprintln(w.table, strings.Join(jobColumns, "\t"))
+		return err
+	case OutputCSV:
+		header := make([]string, len(jobColumns))
+		for i, column := range jobColumns {
+			header[i] = strings.ToLower(column)
+		}
+		return w.csv.Write(header)
+	case OutputJSON:
+		_, err := io.WriteString(w.out, "[")
+		return err
+	}
+	return nil
+}
+
+// Write outputs a single job
+func (w *jobWriter) Write(job *rivertype.JobRow) error {
+	if !w.started {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+	}
+
+	switch w.format {
+	case OutputTable:
+		_, err := fmt.Fprintln(w.table, strings.Join(jobRecord(job), "\t"))
+		return err
+	case OutputCSV:
+		return w.csv.Write(jobRecord(job))
+	case OutputJSON:
+		data, err := json.MarshalIndent(newJobOutput(job), "  ", "  ")
+		if err != nil {
+			return err
+		}
+		sep := "\n  "
+		if w.count > 0 {
+			sep = ",\n  "
+		}
+		w.count++
+		_, err = fmt.Fprintf(w.out, "%s%s", sep, data)
+		return err
+	case OutputNDJSON:
+		data, err := json.Marshal(newJobOutput(job))
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w.out, "%s\n", data)
+		return err
+	}
+	return nil
+}
+
+// Flush completes the output, writing any trailing delimiters
+func (w *jobWriter) Flush() error {
+	if !w.started {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+	}
+
+	switch w.format {
+	case OutputTable:
+		return w.table.Flush()
+	case OutputCSV:
+		w.csv.Flush()
+		return w.csv.Error()
+	case OutputJSON:
+		closing := "]\n"
+		if w.count > 0 {
+			closing = "\n]\n"
+		}
+		_, err := io.WriteString(w.out, closing)
+		return err
+	}
+	return nil
+}